@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"time"
 
 	client "github.com/danimagb/api-client/pkg"
+	"github.com/danimagb/api-client/pkg/core"
 	"github.com/danimagb/api-client/pkg/models"
 	"github.com/google/uuid"
 )
@@ -21,7 +23,7 @@ func main(){
 
 	client, err := client.NewClient(
 		client.WithBaseUrl(*u),
-		client.WithTimeoutInMilliseconds(30),
+		client.WithTimeouts(core.Timeouts{PerAttempt: 30 * time.Millisecond}),
 	)
 	if err != nil {
 		log.Fatal(err)
@@ -45,7 +47,7 @@ func main(){
 				BaseCurrency: "GBP",
 				Bic: "NWBKGB22",
 				Country: &country,
-				Iban: "GB11NWBK40030041426819",
+				Iban: "GB16NWBK40030041426819",
 				JointAccount: &jointAccount,
 				Name: []string{name},
 				SecondaryIdentification: "A1B2C3D4",
@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -16,12 +17,29 @@ const(
 
 type AccountsClient struct{
 	baseClient core.Client
+	validateOnCreate bool
 }
 
-func New(baseClient core.Client) *AccountsClient{
-	return &AccountsClient{
+type Option func(*AccountsClient)
+
+// WithCreateValidation validates the accounts with models.AccountRequest.Validate before sending them in Create,
+// so that invalid accounts fail with models.ValidationErrors without reaching the API.
+func WithCreateValidation() Option{
+	return func(ac *AccountsClient) {
+		ac.validateOnCreate = true
+	}
+}
+
+func New(baseClient core.Client, options ...Option) *AccountsClient{
+	ac := &AccountsClient{
 		baseClient: baseClient,
 	}
+
+	for _, option := range options{
+		option(ac)
+	}
+
+	return ac
 }
 
 func(ac *AccountsClient) Fetch(ctx context.Context, id uuid.UUID) (*models.AccountResponse, error){
@@ -43,40 +61,34 @@ func(ac *AccountsClient) Fetch(ctx context.Context, id uuid.UUID) (*models.Accou
 	}
 
 	if response.StatusCode() != 200{
-		clientError := core.NewApiClientError(
-			"Status code does not represent success for this request",
-			response.StatusCode(),
-			apiError.ErrorMessage, response)
-		return nil, clientError
+		return nil, core.NewErrorFromResponse(response, apiError.ErrorMessage)
 	}
 
 	return accountResponse, nil
 }
 
+// Create sends the account with an idempotency key, reusing the one set with core.ContextWithIdempotencyKey if any,
+// so that it is retried safely. When the outcome of an earlier attempt is unknown and the API answers that the
+// account already exists, the account created by that attempt is fetched and returned instead of the conflict.
 func(ac *AccountsClient) Create(ctx context.Context, accountData *models.AccountRequest) (*models.AccountResponse, error){
-	accountResponse := &models.AccountResponse{}
-	apiError := &models.APIError{}
+	if accountData == nil{
+		return nil, fmt.Errorf("account must not be nil")
+	}
 
-	apiReq := core.NewRequestBuilder(http.MethodPost).
-		WithPath(baseAccountsPath).
-		WithBody(accountData).
-		WithContext(ctx).
-		WithResultWriteTo(accountResponse).
-		WithErrorWriteTo(apiError).
-		Build()
+	if ac.validateOnCreate{
+		if err := accountData.Validate(); err != nil{
+			return nil, err
+		}
+	}
 
-	response, err := ac.baseClient.Send(apiReq)
+	accountResponse := &models.AccountResponse{}
 
-	if err != nil {
-		return  nil, err
-	}
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseAccountsPath).
+		WithBody(accountData)
 
-	if response.StatusCode() != 201 {
-		clientError := core.NewApiClientError(
-			"Status code does not represent success for this request",
-			response.StatusCode(),
-			apiError.ErrorMessage, response)
-		return nil, clientError
+	if err := core.CreateRecord(ctx, ac.baseClient, builder, accountResponse); err != nil {
+		return nil, err
 	}
 
 	return accountResponse, nil
@@ -100,11 +112,7 @@ func(ac *AccountsClient) Delete(ctx context.Context, id uuid.UUID, version int64
 	}
 
 	if response.StatusCode() != 204 {
-		clientError := core.NewApiClientError(
-			"Status code does not represent success for this request",
-			response.StatusCode(),
-			apiError.ErrorMessage, response)
-		return clientError
+		return core.NewErrorFromResponse(response, apiError.ErrorMessage)
 	}
 
 	return nil
@@ -3,39 +3,26 @@ package accounts
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"testing"
 
 	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
 	"github.com/danimagb/api-client/pkg/models"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
-type MockedBaseClient struct {
-	mock.Mock
-}
-
-func (m *MockedBaseClient) Send(req *core.Request) (*core.Response, error) {
-
-	args := m.Called(req)
-
-	if args.Get(0) != nil {
-		return args.Get(0).(*core.Response), args.Error(1)
-	}
-
-	return nil, args.Error(1)
-}
-
 func TestFetch(t *testing.T) {
 	t.Run("Given an error calling base client should return an error", func(t *testing.T) {
 		// Arrange
 		expectedError := fmt.Errorf("Some error occurred")
 
-		mockedBaseClient := new(MockedBaseClient)
+		mockedBaseClient := new(coretest.MockedBaseClient)
 		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
 
 		sut := New(mockedBaseClient)
@@ -58,7 +45,7 @@ func TestFetch(t *testing.T) {
 			RawResponse: httpResponse,
 		}
 
-		mockedBaseClient := new(MockedBaseClient)
+		mockedBaseClient := new(coretest.MockedBaseClient)
 		mockedBaseClient.On("Send", mock.Anything).Return(mockedResponse, nil)
 
 		sut := New(mockedBaseClient)
@@ -80,7 +67,7 @@ func TestFetch(t *testing.T) {
 			RawResponse: httpResponse,
 		}
 
-		mockedBaseClient := new(MockedBaseClient)
+		mockedBaseClient := new(coretest.MockedBaseClient)
 		mockedBaseClient.On("Send", mock.Anything).Return(mockedResponse, nil)
 
 		sut := New(mockedBaseClient)
@@ -91,8 +78,10 @@ func TestFetch(t *testing.T) {
 		// Assert
 		assert.NotNil(t, err)
 		assert.Nil(t, actual)
-		assert.IsType(t, err.(*core.ApiClientError), err)
-		assert.IsType(t, http.StatusInternalServerError, err.(*core.ApiClientError).StatusCode)
+		var apiError *core.ApiClientError
+		assert.True(t, errors.As(err, &apiError))
+		assert.Equal(t, http.StatusInternalServerError, apiError.StatusCode)
+		assert.True(t, errors.Is(err, core.ErrServerError))
 	})
 
 }
@@ -103,7 +92,7 @@ func TestCreate(t *testing.T) {
 		// Arrange
 		expectedError := fmt.Errorf("Some error occurred")
 
-		mockedBaseClient := new(MockedBaseClient)
+		mockedBaseClient := new(coretest.MockedBaseClient)
 		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
 
 		sut := New(mockedBaseClient)
@@ -126,7 +115,7 @@ func TestCreate(t *testing.T) {
 			RawResponse: httpResponse,
 		}
 
-		mockedBaseClient := new(MockedBaseClient)
+		mockedBaseClient := new(coretest.MockedBaseClient)
 		mockedBaseClient.On("Send", mock.Anything).Return(mockedResponse, nil)
 
 		sut := New(mockedBaseClient)
@@ -139,6 +128,140 @@ func TestCreate(t *testing.T) {
 		assert.NotNil(t, actual)
 	})
 
+	t.Run("Given an account should send it with an idempotency key", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Create(context.Background(), &models.AccountRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedBaseClient.Calls[0].Arguments.Get(0).(*core.Request)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the account with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.Create(ctx, &models.AccountRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedBaseClient.Calls[0].Arguments.Get(0).(*core.Request)
+		assert.Equal(t, "some_key-POST"+baseAccountsPath, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a duplicate conflict after a retried attempt should return the account created by the earlier attempt", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		organisationID := uuid.NewString()
+		request := &models.AccountRequest{Data: &models.AccountData{ID: id.String(), OrganisationID: organisationID}}
+
+		conflict := coretest.NewResponse(409)
+		conflict.Attempts = 2
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPost)).Return(conflict, nil)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).Run(func(args mock.Arguments) {
+			result := args.Get(0).(*core.Request).Result.(*models.AccountResponse)
+			result.Data = &models.AccountData{ID: id.String(), OrganisationID: organisationID}
+		})
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), request)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, id.String(), actual.Data.ID)
+		fetchReq := mockedBaseClient.Calls[1].Arguments.Get(0).(*core.Request)
+		assert.Equal(t, baseAccountsPath+"/"+id.String(), fetchReq.Path)
+	})
+
+	t.Run("Given a duplicate conflict after a retried attempt and an account of another organisation should return the conflict error", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		request := &models.AccountRequest{Data: &models.AccountData{ID: id.String(), OrganisationID: uuid.NewString()}}
+
+		conflict := coretest.NewResponse(409)
+		conflict.Attempts = 2
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPost)).Return(conflict, nil)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).Run(func(args mock.Arguments) {
+			result := args.Get(0).(*core.Request).Result.(*models.AccountResponse)
+			result.Data = &models.AccountData{ID: id.String(), OrganisationID: uuid.NewString()}
+		})
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), request)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+	})
+
+	t.Run("Given a duplicate conflict on the first attempt should return the conflict error without fetching", func(t *testing.T) {
+		// Arrange
+		request := &models.AccountRequest{Data: &models.AccountData{ID: uuid.NewString()}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPost)).Return(coretest.NewResponse(409), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), request)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+		mockedBaseClient.AssertNumberOfCalls(t, "Send", 1)
+	})
+
+	t.Run("Given create validation and an invalid account should return validation errors without calling base client", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+
+		sut := New(mockedBaseClient, WithCreateValidation())
+
+		// Act
+		actual, err := sut.Create(context.Background(), &models.AccountRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.IsType(t, models.ValidationErrors{}, err)
+		mockedBaseClient.AssertNotCalled(t, "Send", mock.Anything)
+	})
+
+	t.Run("Given a nil account should return an error without calling base client", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+
+		sut := New(mockedBaseClient, WithCreateValidation())
+
+		// Act
+		actual, err := sut.Create(context.Background(), nil)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+		mockedBaseClient.AssertNotCalled(t, "Send", mock.Anything)
+	})
+
 	t.Run("Given a response with status code other than 201 should return error", func(t *testing.T) {
 		// Arrange
 		statusCode := 500
@@ -148,7 +271,7 @@ func TestCreate(t *testing.T) {
 			RawResponse: httpResponse,
 		}
 
-		mockedBaseClient := new(MockedBaseClient)
+		mockedBaseClient := new(coretest.MockedBaseClient)
 		mockedBaseClient.On("Send", mock.Anything).Return(expected, nil)
 
 		sut := New(mockedBaseClient)
@@ -159,8 +282,10 @@ func TestCreate(t *testing.T) {
 		// Assert
 		assert.NotNil(t, err)
 		assert.Nil(t, actual)
-		assert.IsType(t, err.(*core.ApiClientError), err)
-		assert.IsType(t, http.StatusInternalServerError, err.(*core.ApiClientError).StatusCode)
+		var apiError *core.ApiClientError
+		assert.True(t, errors.As(err, &apiError))
+		assert.Equal(t, http.StatusInternalServerError, apiError.StatusCode)
+		assert.True(t, errors.Is(err, core.ErrServerError))
 	})
 }
 
@@ -169,7 +294,7 @@ func TestDelete(t *testing.T) {
 		// Arrange
 		expectedError := fmt.Errorf("Some error occurred")
 
-		mockedBaseClient := new(MockedBaseClient)
+		mockedBaseClient := new(coretest.MockedBaseClient)
 		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
 
 		sut := New(mockedBaseClient)
@@ -191,7 +316,7 @@ func TestDelete(t *testing.T) {
 			RawResponse: httpResponse,
 		}
 
-		mockedBaseClient := new(MockedBaseClient)
+		mockedBaseClient := new(coretest.MockedBaseClient)
 		mockedBaseClient.On("Send", mock.Anything).Return(mockedResponse, nil)
 
 		sut := New(mockedBaseClient)
@@ -212,7 +337,7 @@ func TestDelete(t *testing.T) {
 			RawResponse: httpResponse,
 		}
 
-		mockedBaseClient := new(MockedBaseClient)
+		mockedBaseClient := new(coretest.MockedBaseClient)
 		mockedBaseClient.On("Send", mock.Anything).Return(expected, nil)
 
 		sut := New(mockedBaseClient)
@@ -222,7 +347,9 @@ func TestDelete(t *testing.T) {
 
 		// Assert
 		assert.NotNil(t, err)
-		assert.IsType(t, err.(*core.ApiClientError), err)
-		assert.IsType(t, http.StatusInternalServerError, err.(*core.ApiClientError).StatusCode)
+		var apiError *core.ApiClientError
+		assert.True(t, errors.As(err, &apiError))
+		assert.Equal(t, http.StatusInternalServerError, apiError.StatusCode)
+		assert.True(t, errors.Is(err, core.ErrServerError))
 	})
 }
\ No newline at end of file
@@ -0,0 +1,153 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultBulkWorkers int = 10
+)
+
+// ErrNotAttempted is the error of the items of a bulk operation that were not sent because the operation
+// stopped on the error of another item.
+var ErrNotAttempted = errors.New("not attempted after an earlier error")
+
+// BulkOptions configures CreateMany and DeleteMany.
+type BulkOptions struct {
+	// Workers is the maximum number of requests in flight, 10 by default.
+	Workers int
+	// StopOnError stops sending new requests after the first failed item. The requests already
+	// in flight complete, and the items not sent fail with ErrNotAttempted.
+	StopOnError bool
+}
+
+// CreateResult is the outcome of the item at Index of a CreateMany call: the response of Create,
+// or its error.
+type CreateResult struct {
+	Index    int
+	Response *models.AccountResponse
+	Err      error
+}
+
+// DeleteItem identifies an account to delete with DeleteMany.
+type DeleteItem struct {
+	ID      uuid.UUID
+	Version int64
+}
+
+// DeleteResult is the outcome of the item at Index of a DeleteMany call.
+type DeleteResult struct {
+	Index int
+	ID    uuid.UUID
+	Err   error
+}
+
+// CreateMany creates the accounts with Create, sending up to opts.Workers requests at a time, and returns
+// one result per account, in the order of accounts. Items not sent because ctx is done fail with the context error.
+//
+// When ctx carries an idempotency key set with core.ContextWithIdempotencyKey, every account is sent with
+// a key derived from it and from its index, so that a retried CreateMany reuses the keys of the first call.
+func (ac *AccountsClient) CreateMany(ctx context.Context, accounts []*models.AccountRequest, opts *BulkOptions) []CreateResult {
+	results := make([]CreateResult, len(accounts))
+	baseKey, hasKey := core.IdempotencyKeyFromContext(ctx)
+
+	run(ctx, len(accounts), opts, func(ctx context.Context, i int) error {
+		if hasKey {
+			ctx = core.ContextWithIdempotencyKey(ctx, fmt.Sprintf("%s-%d", baseKey, i))
+		}
+
+		response, err := ac.Create(ctx, accounts[i])
+		results[i] = CreateResult{Index: i, Response: response, Err: err}
+		return err
+	}, func(i int, err error) {
+		results[i] = CreateResult{Index: i, Err: err}
+	})
+
+	return results
+}
+
+// DeleteMany deletes the accounts with Delete, sending up to opts.Workers requests at a time, and returns
+// one result per item, in the order of items. Items not sent because ctx is done fail with the context error.
+func (ac *AccountsClient) DeleteMany(ctx context.Context, items []DeleteItem, opts *BulkOptions) []DeleteResult {
+	results := make([]DeleteResult, len(items))
+
+	run(ctx, len(items), opts, func(ctx context.Context, i int) error {
+		err := ac.Delete(ctx, items[i].ID, items[i].Version)
+		results[i] = DeleteResult{Index: i, ID: items[i].ID, Err: err}
+		return err
+	}, func(i int, err error) {
+		results[i] = DeleteResult{Index: i, ID: items[i].ID, Err: err}
+	})
+
+	return results
+}
+
+// Calls do for the indexes from 0 to n-1 from up to opts.Workers goroutines, and skip for the indexes
+// not attempted, with the reason they were not.
+func run(ctx context.Context, n int, opts *BulkOptions, do func(ctx context.Context, i int) error, skip func(i int, err error)) {
+	workers := defaultBulkWorkers
+	stopOnError := false
+	if opts != nil {
+		if opts.Workers > 0 {
+			workers = opts.Workers
+		}
+		stopOnError = opts.StopOnError
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		stopped bool
+	)
+
+	// the reason to stop sending new requests, if any
+	stopReason := func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped {
+			return ErrNotAttempted
+		}
+		return nil
+	}
+
+	semaphore := make(chan struct{}, workers)
+
+	for i := 0; i < n; i++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		// checked once the slot is acquired, as an item may have failed or ctx be done while waiting for it
+		if err := stopReason(); err != nil {
+			for j := i; j < n; j++ {
+				skip(j, err)
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := do(ctx, i); err != nil && stopOnError {
+				mu.Lock()
+				stopped = true
+				mu.Unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,187 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// Base client safe for concurrent use, answering 400 for the ids in failing and
+// recording the highest number of requests in flight.
+type concurrentClient struct {
+	mu              sync.Mutex
+	delay           time.Duration
+	failing         map[string]bool
+	inFlight        int
+	maxInFlight     int
+	sent            int
+	idempotencyKeys []string
+}
+
+func (c *concurrentClient) Send(req *core.Request) (*core.Response, error) {
+	c.mu.Lock()
+	c.inFlight++
+	c.sent++
+	if c.inFlight > c.maxInFlight {
+		c.maxInFlight = c.inFlight
+	}
+	if key := req.Headers.Get(core.IdempotencyKeyHeader); key != "" {
+		c.idempotencyKeys = append(c.idempotencyKeys, key)
+	}
+	c.mu.Unlock()
+
+	time.Sleep(c.delay)
+
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+
+	id := requestedID(req)
+	if c.failing[id] {
+		return coretest.NewResponse(400), nil
+	}
+
+	if req.Method == http.MethodDelete {
+		return coretest.NewResponse(204), nil
+	}
+	req.Result.(*models.AccountResponse).Data = &models.AccountData{ID: id}
+	return coretest.NewResponse(201), nil
+}
+
+func requestedID(req *core.Request) string {
+	if body, ok := req.Body.(*models.AccountRequest); ok {
+		return body.Data.ID
+	}
+	return req.Path[len(baseAccountsPath)+1:]
+}
+
+func newAccounts(n int) []*models.AccountRequest {
+	accounts := make([]*models.AccountRequest, n)
+	for i := range accounts {
+		accounts[i] = &models.AccountRequest{Data: &models.AccountData{ID: uuid.NewString()}}
+	}
+	return accounts
+}
+
+func TestCreateMany(t *testing.T) {
+	t.Run("Given many accounts should create them with at most the given workers and keep their order", func(t *testing.T) {
+		// Arrange
+		accounts := newAccounts(20)
+		baseClient := &concurrentClient{delay: 2 * time.Millisecond}
+
+		sut := New(baseClient)
+
+		// Act
+		actual := sut.CreateMany(context.Background(), accounts, &BulkOptions{Workers: 3})
+
+		// Assert
+		assert.Len(t, actual, 20)
+		for i, result := range actual {
+			assert.Equal(t, i, result.Index)
+			assert.Nil(t, result.Err)
+			assert.Equal(t, accounts[i].Data.ID, result.Response.Data.ID)
+		}
+		assert.LessOrEqual(t, baseClient.maxInFlight, 3)
+		assert.Equal(t, 20, baseClient.sent)
+	})
+
+	t.Run("Given failing accounts should return their typed errors along with the other results", func(t *testing.T) {
+		// Arrange
+		accounts := newAccounts(5)
+		baseClient := &concurrentClient{failing: map[string]bool{accounts[1].Data.ID: true, accounts[3].Data.ID: true}}
+
+		sut := New(baseClient)
+
+		// Act
+		actual := sut.CreateMany(context.Background(), accounts, nil)
+
+		// Assert
+		assert.True(t, errors.Is(actual[1].Err, core.ErrBadRequest))
+		assert.True(t, errors.Is(actual[3].Err, core.ErrBadRequest))
+		assert.Nil(t, actual[1].Response)
+		assert.Nil(t, actual[0].Err)
+		assert.Nil(t, actual[2].Err)
+		assert.Nil(t, actual[4].Err)
+	})
+
+	t.Run("Given stop on error should not send the accounts after the first failure", func(t *testing.T) {
+		// Arrange
+		accounts := newAccounts(10)
+		baseClient := &concurrentClient{failing: map[string]bool{accounts[0].Data.ID: true}}
+
+		sut := New(baseClient)
+
+		// Act
+		actual := sut.CreateMany(context.Background(), accounts, &BulkOptions{Workers: 1, StopOnError: true})
+
+		// Assert
+		assert.True(t, errors.Is(actual[0].Err, core.ErrBadRequest))
+		for _, result := range actual[1:] {
+			assert.True(t, errors.Is(result.Err, ErrNotAttempted))
+		}
+		assert.Equal(t, 1, baseClient.sent)
+	})
+
+	t.Run("Given a cancelled context should fail the accounts not sent with the context error", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		baseClient := &concurrentClient{}
+
+		sut := New(baseClient)
+
+		// Act
+		actual := sut.CreateMany(ctx, newAccounts(3), nil)
+
+		// Assert
+		for _, result := range actual {
+			assert.True(t, errors.Is(result.Err, context.Canceled))
+		}
+		assert.Equal(t, 0, baseClient.sent)
+	})
+
+	t.Run("Given an idempotency key in the context should send every account with its own derived key", func(t *testing.T) {
+		// Arrange
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "bulk-key")
+		baseClient := &concurrentClient{}
+
+		sut := New(baseClient)
+
+		// Act
+		sut.CreateMany(ctx, newAccounts(2), &BulkOptions{Workers: 1})
+
+		// Assert
+		assert.Equal(t, []string{"bulk-key-0-POST" + baseAccountsPath, "bulk-key-1-POST" + baseAccountsPath}, baseClient.idempotencyKeys)
+	})
+}
+
+func TestDeleteMany(t *testing.T) {
+	t.Run("Given many accounts should delete them and return one result per item", func(t *testing.T) {
+		// Arrange
+		items := []DeleteItem{{ID: uuid.New()}, {ID: uuid.New(), Version: 2}, {ID: uuid.New()}}
+		baseClient := &concurrentClient{failing: map[string]bool{items[2].ID.String(): true}}
+
+		sut := New(baseClient)
+
+		// Act
+		actual := sut.DeleteMany(context.Background(), items, &BulkOptions{Workers: 2})
+
+		// Assert
+		assert.Len(t, actual, 3)
+		assert.Nil(t, actual[0].Err)
+		assert.Nil(t, actual[1].Err)
+		assert.Equal(t, items[1].ID, actual[1].ID)
+		assert.True(t, errors.Is(actual[2].Err, core.ErrBadRequest))
+		assert.Equal(t, 2, actual[2].Index)
+	})
+}
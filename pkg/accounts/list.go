@@ -0,0 +1,169 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+)
+
+// ListOptions holds the paging and filtering parameters used when listing accounts.
+type ListOptions struct {
+	core.PageOptions
+	Filter ListFilter
+}
+
+// ListFilter restricts the accounts returned by List. Every field accepts multiple values.
+type ListFilter struct {
+	BankID        []string
+	AccountNumber []string
+	Iban          []string
+	Country       []string
+	CustomerID    []string
+}
+
+// List returns a single page of accounts together with the links to the surrounding pages.
+func (ac *AccountsClient) List(ctx context.Context, opts *ListOptions) (*models.AccountListResponse, error) {
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseAccountsPath)
+
+	if opts != nil {
+		builder = opts.apply(builder)
+	}
+
+	return ac.sendList(ctx, builder)
+}
+
+// ListIterator returns an iterator that starts at the page described by opts and follows
+// the Next link of every page until there are no more pages.
+func (ac *AccountsClient) ListIterator(opts *ListOptions) *ListIterator {
+	return &ListIterator{
+		client: ac,
+		opts:   opts,
+	}
+}
+
+func (ac *AccountsClient) listFromLink(ctx context.Context, link string) (*models.AccountListResponse, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("invalid next page link '%s': %w", link, err)
+	}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(u.Path)
+
+	for param, values := range u.Query() {
+		for _, value := range values {
+			builder = builder.AddQueryParam(param, value)
+		}
+	}
+
+	return ac.sendList(ctx, builder)
+}
+
+func (ac *AccountsClient) sendList(ctx context.Context, builder core.RequestBuilder) (*models.AccountListResponse, error) {
+	listResponse := &models.AccountListResponse{}
+	apiError := &models.APIError{}
+
+	apiReq := builder.
+		WithContext(ctx).
+		WithResultWriteTo(listResponse).
+		WithErrorWriteTo(apiError).
+		Build()
+
+	response, err := ac.baseClient.Send(apiReq)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != 200 {
+		return nil, core.NewErrorFromResponse(response, apiError.ErrorMessage)
+	}
+
+	return listResponse, nil
+}
+
+func (opts *ListOptions) apply(builder core.RequestBuilder) core.RequestBuilder {
+	return core.ApplyList(builder, opts.PageOptions, core.ListFilters{
+		"filter[bank_id]":        opts.Filter.BankID,
+		"filter[account_number]": opts.Filter.AccountNumber,
+		"filter[iban]":           opts.Filter.Iban,
+		"filter[country]":        opts.Filter.Country,
+		"filter[customer_id]":    opts.Filter.CustomerID,
+	})
+}
+
+// ListIterator walks through the pages of an account listing.
+//
+//	it := client.Accounts.ListIterator(&accounts.ListOptions{PageOptions: core.PageOptions{PageSize: 100}})
+//	for it.Next(ctx) {
+//		page := it.Page()
+//	}
+//	if err := it.Err(); err != nil {...}
+type ListIterator struct {
+	client   *AccountsClient
+	opts     *ListOptions
+	page     *models.AccountListResponse
+	nextLink string
+	started  bool
+	done     bool
+	err      error
+}
+
+// Next fetches the next page and reports whether it is available through Page.
+// It returns false when the pages are exhausted, the context is done or a request fails.
+func (it *ListIterator) Next(ctx context.Context) bool {
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	var page *models.AccountListResponse
+	var err error
+
+	if !it.started {
+		it.started = true
+		page, err = it.client.List(ctx, it.opts)
+	} else if it.nextLink == "" {
+		it.done = true
+		return false
+	} else {
+		page, err = it.client.listFromLink(ctx, it.nextLink)
+	}
+
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	if it.page != nil && len(page.Data) == 0 {
+		it.done = true
+		return false
+	}
+
+	it.page = page
+	it.nextLink = ""
+	if page.Links != nil && page.Links.Next != nil {
+		it.nextLink = *page.Links.Next
+	}
+
+	return true
+}
+
+// Page returns the page fetched by the last successful call to Next.
+func (it *ListIterator) Page() *models.AccountListResponse {
+	return it.page
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ListIterator) Err() error {
+	return it.err
+}
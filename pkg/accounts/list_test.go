@@ -0,0 +1,195 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newListResponse(statusCode int) *core.Response {
+	httpResponse := &http.Response{StatusCode: statusCode, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}
+
+	return &core.Response{
+		RawResponse: httpResponse,
+	}
+}
+
+func writeListPage(ids []string, next *string) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		result := args.Get(0).(*core.Request).Result.(*models.AccountListResponse)
+		for _, id := range ids {
+			result.Data = append(result.Data, &models.AccountData{ID: id})
+		}
+		result.Links = &models.Links{Next: next}
+	}
+}
+
+func TestList(t *testing.T) {
+	t.Run("Given list options should send the paging and filter query parameters", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(newListResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.List(context.Background(), &ListOptions{
+			PageOptions: core.PageOptions{PageNumber: 2, PageSize: 10},
+			Filter: ListFilter{
+				BankID:  []string{"400300", "400301"},
+				Country: []string{"GB"},
+			},
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+
+		apiReq := mockedBaseClient.Calls[0].Arguments.Get(0).(*core.Request)
+		assert.Equal(t, baseAccountsPath, apiReq.Path)
+		assert.Equal(t, "2", apiReq.QueryParam.Get("page[number]"))
+		assert.Equal(t, "10", apiReq.QueryParam.Get("page[size]"))
+		assert.Equal(t, []string{"400300", "400301"}, apiReq.QueryParam["filter[bank_id]"])
+		assert.Equal(t, []string{"GB"}, apiReq.QueryParam["filter[country]"])
+		assert.NotContains(t, apiReq.QueryParam, "filter[iban]")
+	})
+
+	t.Run("Given an error calling base client should return an error", func(t *testing.T) {
+		// Arrange
+		expectedError := fmt.Errorf("Some error occurred")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.List(context.Background(), nil)
+
+		// Assert
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given a response with status code other than 200 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(newListResponse(500), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.List(context.Background(), nil)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+		assert.IsType(t, &core.ServerError{}, err)
+	})
+}
+
+func TestListIterator(t *testing.T) {
+	t.Run("Given pages linked by next should iterate until the last page", func(t *testing.T) {
+		// Arrange
+		next := "/v1/organisation/accounts?page%5Bnumber%5D=1&page%5Bsize%5D=2"
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(newListResponse(200), nil).
+			Run(writeListPage([]string{"1", "2"}, &next)).Once()
+		mockedBaseClient.On("Send", mock.Anything).Return(newListResponse(200), nil).
+			Run(writeListPage([]string{"3"}, nil)).Once()
+
+		sut := New(mockedBaseClient).ListIterator(&ListOptions{PageOptions: core.PageOptions{PageSize: 2}})
+
+		// Act
+		var actual []string
+		for sut.Next(context.Background()) {
+			for _, account := range sut.Page().Data {
+				actual = append(actual, account.ID)
+			}
+		}
+
+		// Assert
+		assert.Nil(t, sut.Err())
+		assert.Equal(t, []string{"1", "2", "3"}, actual)
+		mockedBaseClient.AssertNumberOfCalls(t, "Send", 2)
+
+		secondReq := mockedBaseClient.Calls[1].Arguments.Get(0).(*core.Request)
+		assert.Equal(t, baseAccountsPath, secondReq.Path)
+		assert.Equal(t, "1", secondReq.QueryParam.Get("page[number]"))
+		assert.Equal(t, "2", secondReq.QueryParam.Get("page[size]"))
+	})
+
+	t.Run("Given an empty page after the first one should stop iterating", func(t *testing.T) {
+		// Arrange
+		next := "/v1/organisation/accounts?page%5Bnumber%5D=1"
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(newListResponse(200), nil).
+			Run(writeListPage([]string{"1"}, &next)).Once()
+		mockedBaseClient.On("Send", mock.Anything).Return(newListResponse(200), nil).
+			Run(writeListPage(nil, &next)).Once()
+
+		sut := New(mockedBaseClient).ListIterator(nil)
+
+		// Act
+		pages := 0
+		for sut.Next(context.Background()) {
+			pages++
+		}
+
+		// Assert
+		assert.Nil(t, sut.Err())
+		assert.Equal(t, 1, pages)
+	})
+
+	t.Run("Given a cancelled context between pages should stop with the context error", func(t *testing.T) {
+		// Arrange
+		next := "/v1/organisation/accounts?page%5Bnumber%5D=1"
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(newListResponse(200), nil).
+			Run(writeListPage([]string{"1"}, &next))
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		sut := New(mockedBaseClient).ListIterator(nil)
+
+		// Act
+		first := sut.Next(ctx)
+		cancel()
+		second := sut.Next(ctx)
+
+		// Assert
+		assert.True(t, first)
+		assert.False(t, second)
+		assert.Equal(t, context.Canceled, sut.Err())
+		mockedBaseClient.AssertNumberOfCalls(t, "Send", 1)
+	})
+
+	t.Run("Given an error fetching a page should stop with that error", func(t *testing.T) {
+		// Arrange
+		expectedError := fmt.Errorf("Some error occurred")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
+
+		sut := New(mockedBaseClient).ListIterator(nil)
+
+		// Act
+		actual := sut.Next(context.Background())
+
+		// Assert
+		assert.False(t, actual)
+		assert.Equal(t, expectedError, sut.Err())
+	})
+}
@@ -0,0 +1,111 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const(
+	accountsType string = "accounts"
+	conflictRetryDelay time.Duration = 20 * time.Millisecond
+	conflictRetryMaxDelay time.Duration = time.Second
+)
+
+// MutateFunc returns the changes to apply to the current state of an account.
+type MutateFunc func(current *models.AccountData) (*models.AccountPatchAttributes, error)
+
+// Update changes the given attributes of the account, provided its current version matches version.
+// A version mismatch fails with a *core.ConflictError.
+func(ac *AccountsClient) Update(ctx context.Context, id uuid.UUID, version int64, patch *models.AccountPatchAttributes) (*models.AccountResponse, error){
+	accountResponse := &models.AccountResponse{}
+	apiError := &models.APIError{}
+
+	body := &models.AccountPatchRequest{
+		Data: &models.AccountPatchData{
+			Attributes: patch,
+			ID: id.String(),
+			Type: accountsType,
+			Version: &version,
+		},
+	}
+
+	apiReq := core.NewRequestBuilder(http.MethodPatch).
+		WithPath(baseAccountsPath).
+		WithPath(id.String()).
+		WithBody(body).
+		WithContext(ctx).
+		WithResultWriteTo(accountResponse).
+		WithErrorWriteTo(apiError).
+		Build()
+
+	response, err := ac.baseClient.Send(apiReq)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != 200 {
+		return nil, core.NewErrorFromResponse(response, apiError.ErrorMessage)
+	}
+
+	return accountResponse, nil
+}
+
+// UpdateWithRetry fetches the account, applies the changes returned by mutate and updates it.
+// When the update fails with a version conflict, it starts over with the latest state of the account,
+// up to maxRetries times, after a delay doubling on every retry with jitter, so that concurrent writers
+// do not keep conflicting. It stops when ctx is done while waiting.
+func(ac *AccountsClient) UpdateWithRetry(ctx context.Context, id uuid.UUID, maxRetries int, mutate MutateFunc) (*models.AccountResponse, error){
+	for attempt := 0; ; attempt++ {
+		current, err := ac.Fetch(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if current.Data == nil {
+			return nil, fmt.Errorf("account %s has no data to update", id)
+		}
+
+		patch, err := mutate(current.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		var version int64
+		if current.Data.Version != nil {
+			version = *current.Data.Version
+		}
+
+		updated, err := ac.Update(ctx, id, version, patch)
+		if err == nil || !errors.Is(err, core.ErrConflict) || attempt >= maxRetries {
+			return updated, err
+		}
+
+		timer := time.NewTimer(conflictRetryDelayFor(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Returns the delay before the retry following the given attempt, doubling from conflictRetryDelay
+// up to conflictRetryMaxDelay, with up to half of it randomly removed.
+func conflictRetryDelayFor(attempt int) time.Duration {
+	delay := conflictRetryDelay << uint(attempt)
+	if delay <= 0 || delay > conflictRetryMaxDelay {
+		delay = conflictRetryMaxDelay
+	}
+
+	return delay - time.Duration(rand.Int63n(int64(delay)/2+1))
+}
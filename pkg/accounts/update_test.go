@@ -0,0 +1,215 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func writeAccountVersion(version int64) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		result := args.Get(0).(*core.Request).Result.(*models.AccountResponse)
+		result.Data = &models.AccountData{Version: &version}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("Given a patch should send it with the account id and version", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Update(context.Background(), id, 3, &models.AccountPatchAttributes{
+			JointAccount: models.Bool(false),
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+
+		apiReq := mockedBaseClient.Calls[0].Arguments.Get(0).(*core.Request)
+		assert.Equal(t, http.MethodPatch, apiReq.Method)
+		assert.Equal(t, baseAccountsPath+"/"+id.String(), apiReq.Path)
+
+		body := apiReq.Body.(*models.AccountPatchRequest)
+		assert.Equal(t, id.String(), body.Data.ID)
+		assert.Equal(t, "accounts", body.Data.Type)
+		assert.Equal(t, int64(3), *body.Data.Version)
+		assert.False(t, *body.Data.Attributes.JointAccount)
+	})
+
+	t.Run("Given an error calling base client should return an error", func(t *testing.T) {
+		// Arrange
+		expectedError := fmt.Errorf("Some error occurred")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Update(context.Background(), uuid.New(), 0, &models.AccountPatchAttributes{})
+
+		// Assert
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given a response with status code 409 should return a conflict error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(409), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Update(context.Background(), uuid.New(), 0, &models.AccountPatchAttributes{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+	})
+}
+
+func TestUpdateWithRetry(t *testing.T) {
+	t.Run("Given a version conflict should fetch the account again and retry with the latest version", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).Run(writeAccountVersion(1)).Once()
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPatch)).Return(coretest.NewResponse(409), nil).Once()
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).Run(writeAccountVersion(2)).Once()
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPatch)).Return(coretest.NewResponse(200), nil).Once()
+
+		sut := New(mockedBaseClient)
+
+		var seenVersions []int64
+
+		// Act
+		actual, err := sut.UpdateWithRetry(context.Background(), uuid.New(), 3, func(current *models.AccountData) (*models.AccountPatchAttributes, error) {
+			seenVersions = append(seenVersions, *current.Version)
+			return &models.AccountPatchAttributes{Status: models.String("closed")}, nil
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, []int64{1, 2}, seenVersions)
+
+		lastPatch := mockedBaseClient.Calls[3].Arguments.Get(0).(*core.Request).Body.(*models.AccountPatchRequest)
+		assert.Equal(t, int64(2), *lastPatch.Data.Version)
+	})
+
+	t.Run("Given version conflicts beyond the max retries should return the conflict error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).Run(writeAccountVersion(1))
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPatch)).Return(coretest.NewResponse(409), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.UpdateWithRetry(context.Background(), uuid.New(), 2, func(current *models.AccountData) (*models.AccountPatchAttributes, error) {
+			return &models.AccountPatchAttributes{}, nil
+		})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+		mockedBaseClient.AssertNumberOfCalls(t, "Send", 6)
+	})
+
+	t.Run("Given a version conflict and a context done while waiting to retry should return the context error", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).Run(writeAccountVersion(1))
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPatch)).Return(coretest.NewResponse(409), nil).Run(func(args mock.Arguments) {
+			cancel()
+		})
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.UpdateWithRetry(ctx, uuid.New(), 3, func(current *models.AccountData) (*models.AccountPatchAttributes, error) {
+			return &models.AccountPatchAttributes{}, nil
+		})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.Equal(t, context.Canceled, err)
+		mockedBaseClient.AssertNumberOfCalls(t, "Send", 2)
+	})
+
+	t.Run("Given an account without data should return an error without mutating it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		mutated := false
+
+		// Act
+		actual, err := sut.UpdateWithRetry(context.Background(), uuid.New(), 2, func(current *models.AccountData) (*models.AccountPatchAttributes, error) {
+			mutated = true
+			return &models.AccountPatchAttributes{}, nil
+		})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+		assert.False(t, mutated)
+		mockedBaseClient.AssertNotCalled(t, "Send", coretest.IsMethod(http.MethodPatch))
+	})
+
+	t.Run("Given a mutation error should return it without updating", func(t *testing.T) {
+		// Arrange
+		expectedError := fmt.Errorf("Some error occurred")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).Run(writeAccountVersion(1))
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.UpdateWithRetry(context.Background(), uuid.New(), 2, func(current *models.AccountData) (*models.AccountPatchAttributes, error) {
+			return nil, expectedError
+		})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.Equal(t, expectedError, err)
+		mockedBaseClient.AssertNotCalled(t, "Send", coretest.IsMethod(http.MethodPatch))
+	})
+}
+
+func TestConflictRetryDelayFor(t *testing.T) {
+	t.Run("Given successive attempts should double the delay up to the max delay with jitter", func(t *testing.T) {
+		for attempt, upper := range []time.Duration{conflictRetryDelay, 2 * conflictRetryDelay, 4 * conflictRetryDelay} {
+			// Act
+			actual := conflictRetryDelayFor(attempt)
+
+			// Assert
+			assert.GreaterOrEqual(t, int64(actual), int64(upper/2))
+			assert.LessOrEqual(t, int64(actual), int64(upper))
+		}
+
+		assert.LessOrEqual(t, int64(conflictRetryDelayFor(100)), int64(conflictRetryMaxDelay))
+	})
+}
@@ -0,0 +1,162 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultWaitInterval    time.Duration = 500 * time.Millisecond
+	defaultWaitMaxInterval time.Duration = 10 * time.Second
+	defaultWaitMultiplier  float64       = 1.5
+)
+
+// ErrTerminalStatus is returned by WaitForStatus when the account reaches a terminal status
+// that is not one of the awaited statuses, e.g. failed while waiting for confirmed.
+var ErrTerminalStatus = errors.New("account reached a terminal status")
+
+// Transition is a change of the status of an account observed by WaitForStatus.
+// The first observation is reported as a transition from an empty status.
+type Transition struct {
+	From    string
+	To      string
+	Account *models.AccountData
+}
+
+// WaitOptions configures how WaitForStatus polls the account. Zero values use the defaults.
+type WaitOptions struct {
+	// Interval is the delay before the second fetch, 500ms by default.
+	Interval time.Duration
+	// Multiplier grows the delay after every fetch, 1.5 by default. 1 polls at a fixed interval.
+	Multiplier float64
+	// MaxInterval caps the delay between fetches, 10s by default.
+	MaxInterval time.Duration
+	// TerminalStatuses are the statuses an account never leaves, failed and closed by default.
+	// Reaching one of them that is not awaited stops the wait with ErrTerminalStatus.
+	TerminalStatuses []string
+	// WaitForExistence keeps polling while the account is not found, instead of failing with a *core.NotFoundError.
+	WaitForExistence bool
+	// OnTransition is called every time the status of the account changes.
+	OnTransition func(transition Transition)
+}
+
+// WaitForStatus fetches the account until its status is one of targetStatuses and returns it.
+// The wait stops with ErrTerminalStatus when the account reaches a terminal status and with the context
+// error when ctx is done; in both cases the last fetched account, if any, is returned along with the error.
+// Fetches failing with a transient error, i.e. a server error, a rate limit, a timeout or a transport error,
+// are retried at the next poll, while any other error stops the wait.
+//
+//	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+//	defer cancel()
+//	account, err := client.Accounts.WaitForStatus(ctx, id, []string{models.AccountStatusConfirmed}, nil)
+func (ac *AccountsClient) WaitForStatus(ctx context.Context, id uuid.UUID, targetStatuses []string, opts *WaitOptions) (*models.AccountResponse, error) {
+	if len(targetStatuses) == 0 {
+		return nil, fmt.Errorf("at least one target status is required")
+	}
+
+	opts = opts.withDefaults()
+
+	var last *models.AccountResponse
+	status, observed := "", false
+	interval := opts.Interval
+
+	for {
+		account, err := ac.Fetch(ctx, id)
+
+		switch {
+		case err == nil:
+			last = account
+			current := accountStatus(account)
+
+			if !observed || current != status {
+				if opts.OnTransition != nil {
+					opts.OnTransition(Transition{From: status, To: current, Account: account.Data})
+				}
+				status, observed = current, true
+			}
+
+			if contains(targetStatuses, current) {
+				return account, nil
+			}
+
+			if contains(opts.TerminalStatuses, current) {
+				return account, fmt.Errorf("account %s is %s: %w", id, current, ErrTerminalStatus)
+			}
+		case errors.Is(err, core.ErrNotFound) && opts.WaitForExistence && !observed:
+		case isTransient(err):
+		default:
+			return last, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * opts.Multiplier)
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+func (opts *WaitOptions) withDefaults() *WaitOptions {
+	withDefaults := WaitOptions{}
+	if opts != nil {
+		withDefaults = *opts
+	}
+
+	if withDefaults.Interval <= 0 {
+		withDefaults.Interval = defaultWaitInterval
+	}
+	if withDefaults.Multiplier < 1 {
+		withDefaults.Multiplier = defaultWaitMultiplier
+	}
+	if withDefaults.MaxInterval <= 0 {
+		withDefaults.MaxInterval = defaultWaitMaxInterval
+	}
+	if withDefaults.MaxInterval < withDefaults.Interval {
+		withDefaults.MaxInterval = withDefaults.Interval
+	}
+	if withDefaults.TerminalStatuses == nil {
+		withDefaults.TerminalStatuses = []string{models.AccountStatusFailed, models.AccountStatusClosed}
+	}
+
+	return &withDefaults
+}
+
+// Reports whether the fetch may succeed when polled again.
+func isTransient(err error) bool {
+	var timeoutError *core.TimeoutError
+	var transportError *core.TransportError
+
+	return errors.Is(err, core.ErrServerError) ||
+		errors.Is(err, core.ErrRateLimited) ||
+		errors.As(err, &timeoutError) ||
+		errors.As(err, &transportError)
+}
+
+func accountStatus(account *models.AccountResponse) string {
+	if account.Data == nil || account.Data.Attributes == nil || account.Data.Attributes.Status == nil {
+		return ""
+	}
+	return *account.Data.Attributes.Status
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// Base client answering the fetches with the given statuses in turn, the last one repeating.
+// An empty status answers 404, a status code answers with that code and transportFailure fails to send.
+const transportFailure = "transport failure"
+
+type statusesClient struct {
+	statuses []string
+	fetches  int
+}
+
+func withStatuses(statuses ...string) *statusesClient {
+	return &statusesClient{statuses: statuses}
+}
+
+func (c *statusesClient) Send(req *core.Request) (*core.Response, error) {
+	status := c.statuses[len(c.statuses)-1]
+	if c.fetches < len(c.statuses) {
+		status = c.statuses[c.fetches]
+	}
+	c.fetches++
+
+	if status == "" {
+		return coretest.NewResponse(404), nil
+	}
+
+	if status == transportFailure {
+		return nil, &core.TransportError{Err: errors.New("connection reset")}
+	}
+
+	if statusCode, err := strconv.Atoi(status); err == nil {
+		return coretest.NewResponse(statusCode), nil
+	}
+
+	req.Result.(*models.AccountResponse).Data = &models.AccountData{Attributes: &models.AccountAttributes{Status: &status}}
+	return coretest.NewResponse(200), nil
+}
+
+func TestWaitForStatus(t *testing.T) {
+	fast := &WaitOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+	t.Run("Given an account becoming confirmed should return it and report every transition", func(t *testing.T) {
+		// Arrange
+		baseClient := withStatuses("pending", "pending", "confirmed")
+
+		transitions := []Transition{}
+		opts := *fast
+		opts.OnTransition = func(transition Transition) {
+			transitions = append(transitions, transition)
+		}
+
+		sut := New(baseClient)
+
+		// Act
+		actual, err := sut.WaitForStatus(context.Background(), uuid.New(), []string{models.AccountStatusConfirmed}, &opts)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, models.AccountStatusConfirmed, *actual.Data.Attributes.Status)
+		assert.Equal(t, 3, baseClient.fetches)
+		assert.Len(t, transitions, 2)
+		assert.Equal(t, "", transitions[0].From)
+		assert.Equal(t, "pending", transitions[0].To)
+		assert.Equal(t, "pending", transitions[1].From)
+		assert.Equal(t, "confirmed", transitions[1].To)
+	})
+
+	t.Run("Given an account becoming failed should return a terminal status error with the account", func(t *testing.T) {
+		// Arrange
+		baseClient := withStatuses("pending", "failed")
+
+		sut := New(baseClient)
+
+		// Act
+		actual, err := sut.WaitForStatus(context.Background(), uuid.New(), []string{models.AccountStatusConfirmed}, fast)
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrTerminalStatus))
+		assert.Equal(t, models.AccountStatusFailed, *actual.Data.Attributes.Status)
+	})
+
+	t.Run("Given an account staying pending should stop when the context is done", func(t *testing.T) {
+		// Arrange
+		baseClient := withStatuses("pending")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		sut := New(baseClient)
+
+		// Act
+		actual, err := sut.WaitForStatus(ctx, uuid.New(), []string{models.AccountStatusConfirmed}, fast)
+
+		// Assert
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, models.AccountStatusPending, *actual.Data.Attributes.Status)
+	})
+
+	t.Run("Given an account not found yet and waiting for existence should keep polling", func(t *testing.T) {
+		// Arrange
+		baseClient := withStatuses("", "", "confirmed")
+
+		opts := *fast
+		opts.WaitForExistence = true
+
+		sut := New(baseClient)
+
+		// Act
+		actual, err := sut.WaitForStatus(context.Background(), uuid.New(), []string{models.AccountStatusConfirmed}, &opts)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, 3, baseClient.fetches)
+	})
+
+	t.Run("Given an account not found without waiting for existence should return a not found error", func(t *testing.T) {
+		// Arrange
+		baseClient := withStatuses("")
+
+		sut := New(baseClient)
+
+		// Act
+		actual, err := sut.WaitForStatus(context.Background(), uuid.New(), []string{models.AccountStatusConfirmed}, fast)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+		assert.Equal(t, 1, baseClient.fetches)
+	})
+
+	t.Run("Given transient errors while fetching should keep polling", func(t *testing.T) {
+		// Arrange
+		baseClient := withStatuses("pending", "503", "429", transportFailure, "confirmed")
+
+		sut := New(baseClient)
+
+		// Act
+		actual, err := sut.WaitForStatus(context.Background(), uuid.New(), []string{models.AccountStatusConfirmed}, fast)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, models.AccountStatusConfirmed, *actual.Data.Attributes.Status)
+		assert.Equal(t, 5, baseClient.fetches)
+	})
+
+	t.Run("Given a gateway answering with an html page while fetching should keep polling", func(t *testing.T) {
+		// Arrange
+		fetches := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fetches++
+			if fetches == 1 {
+				w.Header().Set("Content-Type", "text/html")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte("<html><body>503 Service Temporarily Unavailable</body></html>"))
+				return
+			}
+			w.Write([]byte(`{"data":{"attributes":{"status":"confirmed"}}}`))
+		}))
+		defer server.Close()
+
+		baseURL, _ := url.Parse(server.URL)
+
+		sut := New(&core.BaseClient{BaseUrl: *baseURL, HttpClient: server.Client()})
+
+		// Act
+		actual, err := sut.WaitForStatus(context.Background(), uuid.New(), []string{models.AccountStatusConfirmed}, fast)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, models.AccountStatusConfirmed, *actual.Data.Attributes.Status)
+		assert.Equal(t, 2, fetches)
+	})
+
+	t.Run("Given a permanent error while fetching should return it with the last fetched account", func(t *testing.T) {
+		// Arrange
+		baseClient := withStatuses("pending", "400", "confirmed")
+
+		sut := New(baseClient)
+
+		// Act
+		actual, err := sut.WaitForStatus(context.Background(), uuid.New(), []string{models.AccountStatusConfirmed}, fast)
+
+		// Assert
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
+		assert.Equal(t, models.AccountStatusPending, *actual.Data.Attributes.Status)
+		assert.Equal(t, 2, baseClient.fetches)
+	})
+
+	t.Run("Given no target status should return an error without fetching", func(t *testing.T) {
+		// Arrange
+		baseClient := withStatuses("pending")
+
+		sut := New(baseClient)
+
+		// Act
+		actual, err := sut.WaitForStatus(context.Background(), uuid.New(), nil, nil)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, baseClient.fetches)
+	})
+}
+
+func TestWaitOptionsWithDefaults(t *testing.T) {
+	t.Run("Given no options should use the defaults", func(t *testing.T) {
+		// Act
+		actual := (*WaitOptions)(nil).withDefaults()
+
+		// Assert
+		assert.Equal(t, defaultWaitInterval, actual.Interval)
+		assert.Equal(t, defaultWaitMultiplier, actual.Multiplier)
+		assert.Equal(t, defaultWaitMaxInterval, actual.MaxInterval)
+		assert.Equal(t, []string{models.AccountStatusFailed, models.AccountStatusClosed}, actual.TerminalStatuses)
+	})
+}
@@ -0,0 +1,249 @@
+// Package auth provides authentication components that plug into the core.BaseClient middleware chain.
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/danimagb/api-client/pkg/core"
+)
+
+const (
+	defaultExpiryMargin time.Duration = 30 * time.Second
+	defaultTokenTimeout time.Duration = 10 * time.Second
+)
+
+// Token is an access token obtained from the token endpoint.
+// A zero ExpiresAt means the token does not expire on its own.
+type Token struct {
+	AccessToken string
+	TokenType   string
+	ExpiresAt   time.Time
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// A token request in flight, shared by every caller waiting for a token.
+type tokenCall struct {
+	done  chan struct{}
+	token *Token
+	err   error
+}
+
+// ClientCredentials obtains bearer tokens through the OAuth2 client credentials grant.
+// Tokens are cached until shortly before they expire and concurrent callers share a single token request.
+type ClientCredentials struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	httpClient   core.HTTPClient
+	// ExpiryMargin is how long before its expiry a cached token is refreshed.
+	ExpiryMargin time.Duration
+	// Timeout bounds each token request. A token request is shared by every waiting caller, so it is
+	// not cancelled along with the caller that started it.
+	Timeout time.Duration
+
+	mu       sync.Mutex
+	token    *Token
+	inFlight *tokenCall
+	now      func() time.Time
+}
+
+// NewClientCredentials returns a ClientCredentials requesting tokens from tokenURL with the given http client.
+func NewClientCredentials(tokenURL, clientID, clientSecret string, scopes []string, httpClient core.HTTPClient) *ClientCredentials {
+	return &ClientCredentials{
+		tokenURL:     tokenURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		scopes:       scopes,
+		httpClient:   httpClient,
+		ExpiryMargin: defaultExpiryMargin,
+		Timeout:      defaultTokenTimeout,
+		now:          time.Now,
+	}
+}
+
+// Token returns the cached token while it is valid, otherwise it requests a new one.
+// A caller whose ctx is done stops waiting for the token request, which keeps running for the other callers.
+func (c *ClientCredentials) Token(ctx context.Context) (*Token, error) {
+	c.mu.Lock()
+
+	if c.token != nil && c.isValid(c.token) {
+		token := c.token
+		c.mu.Unlock()
+		return token, nil
+	}
+
+	call := c.inFlight
+	if call == nil {
+		call = &tokenCall{done: make(chan struct{})}
+		c.inFlight = call
+		go c.fetch(ctx, call)
+	}
+
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Invalidate drops the cached token if it is still the given access token,
+// so that the next call to Token requests a new one.
+func (c *ClientCredentials) Invalidate(accessToken string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != nil && c.token.AccessToken == accessToken {
+		c.token = nil
+	}
+}
+
+// Middleware sets the bearer token in every request and, when a request is rejected with
+// 401 Unauthorized, retries it once with a fresh token.
+func (c *ClientCredentials) Middleware() core.Middleware {
+	return func(next core.Handler) core.Handler {
+		return func(apiReq *core.Request, httpReq *http.Request) (*core.Response, error) {
+			token, err := c.Token(httpReq.Context())
+			if err != nil {
+				return nil, fmt.Errorf("Error while obtaining oauth2 token: %w", err)
+			}
+
+			retryReq, err := cloneRequest(httpReq)
+			if err != nil {
+				return nil, err
+			}
+
+			setAuthorization(httpReq, token)
+
+			apiResponse, err := next(apiReq, httpReq)
+			if err != nil || apiResponse.StatusCode() != http.StatusUnauthorized {
+				return apiResponse, err
+			}
+
+			c.Invalidate(token.AccessToken)
+
+			token, err = c.Token(httpReq.Context())
+			if err != nil {
+				return nil, fmt.Errorf("Error while refreshing oauth2 token: %w", err)
+			}
+
+			setAuthorization(retryReq, token)
+
+			return next(apiReq, retryReq)
+		}
+	}
+}
+
+func (c *ClientCredentials) isValid(token *Token) bool {
+	return token.ExpiresAt.IsZero() || c.now().Add(c.ExpiryMargin).Before(token.ExpiresAt)
+}
+
+// Requests a token and shares the outcome with every caller waiting on the call.
+// The request keeps the values of ctx but not its cancellation, being bounded by the Timeout instead.
+func (c *ClientCredentials) fetch(ctx context.Context, call *tokenCall) {
+	ctx = context.WithoutCancel(ctx)
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	token, err := c.requestToken(ctx)
+
+	c.mu.Lock()
+	if err == nil {
+		c.token = token
+	}
+	c.inFlight = nil
+	c.mu.Unlock()
+
+	call.token, call.err = token, err
+	close(call.done)
+}
+
+func (c *ClientCredentials) requestToken(ctx context.Context) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if len(c.scopes) > 0 {
+		form.Set("scope", strings.Join(c.scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(c.clientID), url.QueryEscape(c.clientSecret))
+
+	issuedAt := c.now()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenResp := &tokenResponse{}
+	if err := json.Unmarshal(body, tokenResp); err != nil {
+		return nil, fmt.Errorf("invalid token response (Status Code: %d): %w", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != http.StatusOK || tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("token request failed (Status Code: %d | Error: '%s' | Description: '%s')",
+			resp.StatusCode, tokenResp.Error, tokenResp.ErrorDescription)
+	}
+
+	token := &Token{
+		AccessToken: tokenResp.AccessToken,
+		TokenType:   tokenResp.TokenType,
+	}
+	if tokenResp.ExpiresIn > 0 {
+		token.ExpiresAt = issuedAt.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+func setAuthorization(httpReq *http.Request, token *Token) {
+	httpReq.Header.Set("Authorization", "Bearer "+token.AccessToken)
+}
+
+// Clones the request with a fresh body so it can be sent again.
+func cloneRequest(httpReq *http.Request) (*http.Request, error) {
+	clone := httpReq.Clone(httpReq.Context())
+
+	if httpReq.GetBody != nil {
+		body, err := httpReq.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+
+	return clone, nil
+}
@@ -0,0 +1,239 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// Starts a token server issuing access-token-1, access-token-2... and counting the token requests.
+func newTokenServer(t *testing.T, expiresIn int, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID, secret, _ := r.BasicAuth()
+		r.ParseForm()
+
+		if clientID != "some_client" || secret != "some_secret" || r.Form.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":"invalid_client","error_description":"bad credentials"}`)
+			return
+		}
+
+		count := atomic.AddInt32(requests, 1)
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprintf(w, `{"access_token":"access-token-%d","token_type":"Bearer","expires_in":%d,"scope":"%s"}`, count, expiresIn, r.Form.Get("scope"))
+	}))
+}
+
+func TestToken(t *testing.T) {
+	t.Run("Given a valid cached token should not request a new one", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		server := newTokenServer(t, 3600, &requests)
+		defer server.Close()
+
+		sut := NewClientCredentials(server.URL, "some_client", "some_secret", []string{"accounts"}, server.Client())
+
+		// Act
+		first, err1 := sut.Token(context.Background())
+		second, err2 := sut.Token(context.Background())
+
+		// Assert
+		assert.Nil(t, err1)
+		assert.Nil(t, err2)
+		assert.Equal(t, "access-token-1", first.AccessToken)
+		assert.Same(t, first, second)
+		assert.Equal(t, int32(1), requests)
+	})
+
+	t.Run("Given concurrent callers should request a single token", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		server := newTokenServer(t, 3600, &requests)
+		defer server.Close()
+
+		sut := NewClientCredentials(server.URL, "some_client", "some_secret", nil, server.Client())
+
+		// Act
+		var wg sync.WaitGroup
+		tokens := make([]string, 20)
+		for i := range tokens {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				token, err := sut.Token(context.Background())
+				if err == nil {
+					tokens[i] = token.AccessToken
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		// Assert
+		assert.Equal(t, int32(1), requests)
+		for _, token := range tokens {
+			assert.Equal(t, "access-token-1", token)
+		}
+	})
+
+	t.Run("Given a token close to expiry should request a new one", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		server := newTokenServer(t, 60, &requests)
+		defer server.Close()
+
+		sut := NewClientCredentials(server.URL, "some_client", "some_secret", nil, server.Client())
+
+		first, _ := sut.Token(context.Background())
+		sut.now = func() time.Time { return time.Now().Add(45 * time.Second) }
+
+		// Act
+		second, err := sut.Token(context.Background())
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "access-token-1", first.AccessToken)
+		assert.Equal(t, "access-token-2", second.AccessToken)
+	})
+
+	t.Run("Given the caller that started the token request is cancelled should still share the token with the other callers", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		server := newTokenServer(t, 3600, &requests)
+		defer server.Close()
+
+		sut := NewClientCredentials(server.URL, "some_client", "some_secret", nil, server.Client())
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, cancelledErr := sut.Token(ctx)
+		actual, err := sut.Token(context.Background())
+
+		// Assert
+		assert.ErrorIs(t, cancelledErr, context.DeadlineExceeded)
+		assert.Nil(t, err)
+		assert.Equal(t, "access-token-1", actual.AccessToken)
+		assert.Equal(t, int32(1), requests)
+	})
+
+	t.Run("Given a token request slower than the timeout should return an error", func(t *testing.T) {
+		// Arrange
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+
+		sut := NewClientCredentials(server.URL, "some_client", "some_secret", nil, server.Client())
+		sut.Timeout = 5 * time.Millisecond
+
+		// Act
+		actual, err := sut.Token(context.Background())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Given invalid client credentials should return an error", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		server := newTokenServer(t, 3600, &requests)
+		defer server.Close()
+
+		sut := NewClientCredentials(server.URL, "some_client", "wrong_secret", nil, server.Client())
+
+		// Act
+		actual, err := sut.Token(context.Background())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid_client")
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("Given a request rejected with 401 should retry it once with a fresh token", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		tokenServer := newTokenServer(t, 3600, &requests)
+		defer tokenServer.Close()
+
+		var authorizations []string
+		apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorizations = append(authorizations, r.Header.Get("Authorization"))
+			if r.Header.Get("Authorization") != "Bearer access-token-2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer apiServer.Close()
+
+		credentials := NewClientCredentials(tokenServer.URL, "some_client", "some_secret", nil, tokenServer.Client())
+		baseUrl, _ := url.Parse(apiServer.URL)
+
+		sut := &core.BaseClient{
+			BaseUrl:     *baseUrl,
+			HttpClient:  apiServer.Client(),
+			Timeout:     1000,
+			Middlewares: []core.Middleware{credentials.Middleware()},
+		}
+
+		apiReq := core.NewRequestBuilder(http.MethodPost).
+			WithBody(map[string]string{"id": "some_id"}).
+			Build()
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, actual.StatusCode())
+		assert.Equal(t, []string{"Bearer access-token-1", "Bearer access-token-2"}, authorizations)
+	})
+
+	t.Run("Given a request rejected with 401 twice should return the 401 response", func(t *testing.T) {
+		// Arrange
+		var requests int32
+		tokenServer := newTokenServer(t, 3600, &requests)
+		defer tokenServer.Close()
+
+		var apiRequests int32
+		apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&apiRequests, 1)
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer apiServer.Close()
+
+		credentials := NewClientCredentials(tokenServer.URL, "some_client", "some_secret", nil, tokenServer.Client())
+		baseUrl, _ := url.Parse(apiServer.URL)
+
+		sut := &core.BaseClient{
+			BaseUrl:     *baseUrl,
+			HttpClient:  apiServer.Client(),
+			Timeout:     1000,
+			Middlewares: []core.Middleware{credentials.Middleware()},
+		}
+
+		// Act
+		actual, err := sut.Send(core.NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusUnauthorized, actual.StatusCode())
+		assert.Equal(t, int32(2), apiRequests)
+	})
+}
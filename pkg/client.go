@@ -6,23 +6,71 @@ import (
 	"net/url"
 
 	"github.com/danimagb/api-client/pkg/accounts"
+	"github.com/danimagb/api-client/pkg/auth"
+	"github.com/danimagb/api-client/pkg/confirmationofpayee"
 	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/directdebits"
+	"github.com/danimagb/api-client/pkg/mandates"
+	"github.com/danimagb/api-client/pkg/organisations"
+	"github.com/danimagb/api-client/pkg/payments"
+	"github.com/danimagb/api-client/pkg/subscriptions"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	defaultBaseURL   = "https://api.dummy.tech/"
 )
 
+// Resource identifies a resource client, e.g. to set its default timeouts.
+type Resource string
+
+const (
+	ResourceAccounts Resource = "accounts"
+	ResourcePayments Resource = "payments"
+	ResourceMandates Resource = "mandates"
+	ResourceDirectDebits Resource = "directdebits"
+	ResourceSubscriptions Resource = "subscriptions"
+	ResourceOrganisations Resource = "organisations"
+	ResourceConfirmationOfPayee Resource = "confirmationofpayee"
+)
+
 type Client struct{
 	httpClient *http.Client
 	baseUrl	url.URL
 	userAgent string
 	timeout int
+	timeouts *core.Timeouts
+	resourceTimeouts map[Resource]core.ResourceTimeouts
+	retryPolicy *core.RetryPolicy
+	middlewares []core.Middleware
+	signer core.RequestSigner
+	rateLimiter *core.RateLimiter
+	circuitBreaker *core.CircuitBreaker
+	telemetry *core.Telemetry
+	logger *core.RequestLogger
+	maxResponseBytes int64
+	retainBody bool
+	oauth2 *oauth2Config
+	accountsOptions []accounts.Option
 	Accounts *accounts.AccountsClient
+	Payments *payments.PaymentsClient
+	Mandates *mandates.MandatesClient
+	DirectDebits *directdebits.DirectDebitsClient
+	Subscriptions *subscriptions.SubscriptionsClient
+	Organisations *organisations.OrganisationsClient
+	ConfirmationOfPayee *confirmationofpayee.ConfirmationOfPayeeClient
 }
 
 type ClientOption func (*Client) error
 
+type oauth2Config struct{
+	tokenURL string
+	clientID string
+	clientSecret string
+	scopes []string
+}
+
 
 func NewClient(options ... ClientOption) (*Client, error){
 	httpClient := &http.Client{}
@@ -42,19 +90,55 @@ func NewClient(options ... ClientOption) (*Client, error){
 		}
 	}
 
+	// The token middleware goes last so that it wraps the actual request, whatever options order was used
+	middlewares := client.middlewares
+	if client.oauth2 != nil{
+		credentials := auth.NewClientCredentials(
+			client.oauth2.tokenURL,
+			client.oauth2.clientID,
+			client.oauth2.clientSecret,
+			client.oauth2.scopes,
+			client.httpClient)
+		middlewares = append(middlewares, credentials.Middleware())
+	}
+
 	baseClient := &core.BaseClient{
 		BaseUrl: client.baseUrl,
 		UserAgent: client.userAgent,
 		HttpClient: client.httpClient,
 		Timeout: client.timeout,
+		Timeouts: client.timeouts,
+		RetryPolicy: client.retryPolicy,
+		Middlewares: middlewares,
+		Signer: client.signer,
+		RateLimiter: client.rateLimiter,
+		CircuitBreaker: client.circuitBreaker,
+		Telemetry: client.telemetry,
+		Logger: client.logger,
+		MaxResponseBytes: client.maxResponseBytes,
+		RetainBody: client.retainBody,
 	}
 
 
-	client.Accounts = accounts.New(baseClient)
+	client.Accounts = accounts.New(client.resourceClient(baseClient, ResourceAccounts), client.accountsOptions...)
+	client.Payments = payments.New(client.resourceClient(baseClient, ResourcePayments))
+	client.Mandates = mandates.New(client.resourceClient(baseClient, ResourceMandates))
+	client.DirectDebits = directdebits.New(client.resourceClient(baseClient, ResourceDirectDebits))
+	client.Subscriptions = subscriptions.New(client.resourceClient(baseClient, ResourceSubscriptions))
+	client.Organisations = organisations.New(client.resourceClient(baseClient, ResourceOrganisations))
+	client.ConfirmationOfPayee = confirmationofpayee.New(client.resourceClient(baseClient, ResourceConfirmationOfPayee))
 
 	return client, nil
 }
 
+// Returns the base client applying the default timeouts of the resource, if any.
+func (client *Client) resourceClient(baseClient core.Client, resource Resource) core.Client{
+	if timeouts, ok := client.resourceTimeouts[resource]; ok{
+		return core.WithDefaultTimeouts(baseClient, timeouts)
+	}
+	return baseClient
+}
+
 func WithHttpClient(c *http.Client) ClientOption{
 	return func(client *Client) error {
 		if c != nil{
@@ -78,6 +162,9 @@ func WithUserAgent(userAgent string) ClientOption{
 	}
 }
 
+// WithTimeoutInMilliseconds bounds each attempt of the requests.
+//
+// Deprecated: use WithTimeouts with core.Timeouts{PerAttempt: ...}, which takes precedence.
 func WithTimeoutInMilliseconds(timeout int) ClientOption{
 	return func(client *Client) error {
 		if timeout > 0{
@@ -86,4 +173,219 @@ func WithTimeoutInMilliseconds(timeout int) ClientOption{
 		}
 		return fmt.Errorf("timeout must be greater than zero (actual timeout: %d)", timeout)
 	}
+}
+
+// WithTimeouts sets the time budgets of every request, which the resource defaults, the requests
+// and core.ContextWithTimeouts can replace. The deadline of the request context always applies as well.
+func WithTimeouts(timeouts core.Timeouts) ClientOption{
+	return func(client *Client) error {
+		if err := validateTimeouts(timeouts); err != nil{
+			return err
+		}
+		client.timeouts = &timeouts
+		return nil
+	}
+}
+
+// WithResourceTimeouts sets the default time budgets of the requests of a resource client,
+// e.g. to allow its list requests more time. They replace the budgets set with WithTimeouts.
+func WithResourceTimeouts(resource Resource, timeouts core.ResourceTimeouts) ClientOption{
+	return func(client *Client) error {
+		switch resource{
+		case ResourceAccounts, ResourcePayments, ResourceMandates, ResourceDirectDebits,
+			ResourceSubscriptions, ResourceOrganisations, ResourceConfirmationOfPayee:
+		default:
+			return fmt.Errorf("unknown resource '%s'", resource)
+		}
+		if err := validateTimeouts(timeouts.Timeouts); err != nil{
+			return err
+		}
+		if err := validateTimeouts(timeouts.List); err != nil{
+			return err
+		}
+		if client.resourceTimeouts == nil{
+			client.resourceTimeouts = map[Resource]core.ResourceTimeouts{}
+		}
+		client.resourceTimeouts[resource] = timeouts
+		return nil
+	}
+}
+
+func validateTimeouts(timeouts core.Timeouts) error{
+	if timeouts.Connect < 0 || timeouts.TLSHandshake < 0 || timeouts.ResponseHeader < 0 || timeouts.PerAttempt < 0 || timeouts.Operation < 0{
+		return fmt.Errorf("timeouts must not be negative (actual timeouts: %+v)", timeouts)
+	}
+	return nil
+}
+
+// WithRetryPolicy enables automatic retries of failed requests according to the given policy.
+// core.DefaultRetryPolicy() provides sensible defaults.
+func WithRetryPolicy(policy *core.RetryPolicy) ClientOption{
+	return func(client *Client) error {
+		if policy == nil{
+			return fmt.Errorf("retry policy must not be nil")
+		}
+		if policy.MaxAttempts < 1{
+			return fmt.Errorf("retry policy max attempts must be greater than zero (actual max attempts: %d)", policy.MaxAttempts)
+		}
+		if policy.Jitter < 0 || policy.Jitter > 1{
+			return fmt.Errorf("retry policy jitter must be between 0 and 1 (actual jitter: %v)", policy.Jitter)
+		}
+		client.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithMiddleware adds middlewares to the chain every request goes through.
+// Middlewares run in the order they are added, across all the WithMiddleware options.
+func WithMiddleware(middlewares ...core.Middleware) ClientOption{
+	return func(client *Client) error {
+		for _, middleware := range middlewares{
+			if middleware == nil{
+				return fmt.Errorf("middleware must not be nil")
+			}
+		}
+		client.middlewares = append(client.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// WithRequestSigner signs every request right before it is sent, e.g. with a *signing.Signer.
+func WithRequestSigner(signer core.RequestSigner) ClientOption{
+	return func(client *Client) error {
+		if signer == nil{
+			return fmt.Errorf("request signer must not be nil")
+		}
+		client.signer = signer
+		return nil
+	}
+}
+
+// WithRateLimit limits the requests sent by all the resource clients to rps requests per second on average,
+// with bursts of up to burst requests. Requests wait for their turn while their context allows it.
+func WithRateLimit(rps float64, burst int) ClientOption{
+	return func(client *Client) error {
+		if err := validateRateLimit(rps, burst); err != nil{
+			return err
+		}
+		client.rateLimiter = core.NewRateLimiter(rps, burst)
+		return nil
+	}
+}
+
+// WithAdaptiveRateLimit is like WithRateLimit, but also slows down following the X-RateLimit-* and Retry-After
+// headers of the responses.
+func WithAdaptiveRateLimit(rps float64, burst int) ClientOption{
+	return func(client *Client) error {
+		if err := validateRateLimit(rps, burst); err != nil{
+			return err
+		}
+		client.rateLimiter = core.NewAdaptiveRateLimiter(rps, burst)
+		return nil
+	}
+}
+
+func validateRateLimit(rps float64, burst int) error{
+	if rps <= 0{
+		return fmt.Errorf("rate limit must be greater than zero (actual rate limit: %v)", rps)
+	}
+	if burst < 1{
+		return fmt.Errorf("rate limit burst must be greater than zero (actual burst: %d)", burst)
+	}
+	return nil
+}
+
+// WithCircuitBreaker fails fast with core.ErrCircuitOpen the requests to the endpoints that keep failing,
+// according to the given policy. core.DefaultCircuitBreakerPolicy() provides sensible defaults.
+func WithCircuitBreaker(policy *core.CircuitBreakerPolicy) ClientOption{
+	return func(client *Client) error {
+		if policy == nil{
+			return fmt.Errorf("circuit breaker policy must not be nil")
+		}
+		if policy.FailureRatio <= 0 || policy.FailureRatio > 1{
+			return fmt.Errorf("circuit breaker failure ratio must be greater than 0 and at most 1 (actual failure ratio: %v)", policy.FailureRatio)
+		}
+		if policy.MinimumRequests < 1{
+			return fmt.Errorf("circuit breaker minimum requests must be greater than zero (actual minimum requests: %d)", policy.MinimumRequests)
+		}
+		if policy.Window <= 0 || policy.CoolDown <= 0{
+			return fmt.Errorf("circuit breaker window and cool-down must be greater than zero (actual window: %v, cool-down: %v)", policy.Window, policy.CoolDown)
+		}
+		client.circuitBreaker = core.NewCircuitBreaker(policy)
+		return nil
+	}
+}
+
+// WithTelemetry records every API call as an OpenTelemetry client span, propagated to the API through the
+// traceparent header, along with duration, in-flight and error metrics. Nil providers fall back to the global ones.
+func WithTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) ClientOption{
+	return func(client *Client) error {
+		telemetry, err := core.NewTelemetry(tracerProvider, meterProvider)
+		if err != nil{
+			return fmt.Errorf("error when creating telemetry %w", err)
+		}
+		client.telemetry = telemetry
+		return nil
+	}
+}
+
+// WithLogger logs every attempt sent by the resource clients, e.g. with core.NewRequestLogger(slog.Default()).
+func WithLogger(logger *core.RequestLogger) ClientOption{
+	return func(client *Client) error {
+		if logger == nil || logger.Logger == nil{
+			return fmt.Errorf("logger must not be nil")
+		}
+		client.logger = logger
+		return nil
+	}
+}
+
+// WithMaxResponseBytes limits the size of the response bodies, 10MB by default. Larger responses fail with
+// a *core.ResponseTooLargeError, matching core.ErrResponseTooLarge, without being decoded.
+func WithMaxResponseBytes(maxResponseBytes int64) ClientOption{
+	return func(client *Client) error {
+		if maxResponseBytes <= 0{
+			return fmt.Errorf("max response bytes must be greater than zero (actual max response bytes: %d)", maxResponseBytes)
+		}
+		client.maxResponseBytes = maxResponseBytes
+		return nil
+	}
+}
+
+// WithRetainBody keeps the raw body of every response for core.Response.Body, e.g. for debugging.
+// Otherwise only the bodies up to 64KB are kept, the larger ones being decoded as they are read.
+func WithRetainBody() ClientOption{
+	return func(client *Client) error {
+		client.retainBody = true
+		return nil
+	}
+}
+
+// WithOAuth2ClientCredentials authenticates every request with a bearer token obtained from tokenURL
+// through the OAuth2 client credentials grant. Tokens are cached and refreshed before they expire.
+func WithOAuth2ClientCredentials(tokenURL string, clientID string, clientSecret string, scopes []string) ClientOption{
+	return func(client *Client) error {
+		u, err := url.Parse(tokenURL)
+		if err != nil || !u.IsAbs(){
+			return fmt.Errorf("token url must be an absolute url (actual token url: '%s')", tokenURL)
+		}
+		if clientID == ""{
+			return fmt.Errorf("client id must not be empty")
+		}
+		client.oauth2 = &oauth2Config{
+			tokenURL: tokenURL,
+			clientID: clientID,
+			clientSecret: clientSecret,
+			scopes: scopes,
+		}
+		return nil
+	}
+}
+
+// WithAccountValidation validates accounts on the client side before creating them.
+func WithAccountValidation() ClientOption{
+	return func(client *Client) error {
+		client.accountsOptions = append(client.accountsOptions, accounts.WithCreateValidation())
+		return nil
+	}
 }
\ No newline at end of file
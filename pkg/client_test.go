@@ -1,11 +1,22 @@
 package client
 
 import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/danimagb/api-client/pkg/signing"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestNewClient(t *testing.T) {
@@ -21,6 +32,12 @@ func TestNewClient(t *testing.T) {
 		assert.Empty(t, actual.userAgent)
 		assert.Empty(t, actual.timeout)
 		assert.NotEmpty(t, actual.Accounts)
+		assert.NotEmpty(t, actual.Payments)
+		assert.NotEmpty(t, actual.Mandates)
+		assert.NotEmpty(t, actual.DirectDebits)
+		assert.NotEmpty(t, actual.Subscriptions)
+		assert.NotEmpty(t, actual.Organisations)
+		assert.NotEmpty(t, actual.ConfirmationOfPayee)
 	})
 
 	t.Run("Given an option to set Http Client should return a client with that specific Http Client", func(t *testing.T) {
@@ -65,6 +82,69 @@ func TestNewClient(t *testing.T) {
 		assert.Nil(t, actual)
 	})
 
+	t.Run("Given an option to set Timeouts should return a client with those specific Timeouts", func(t *testing.T) {
+		// Arrange
+		expected := core.Timeouts{Connect: time.Second, PerAttempt: 2 * time.Second}
+
+		// Act
+		actual, err := NewClient(
+			WithTimeouts(expected),
+		)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, &expected, actual.timeouts)
+	})
+
+	t.Run("Given an option to set negative Timeouts should return an error", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithTimeouts(core.Timeouts{Operation: -time.Second}),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given an option to set Resource Timeouts should apply them to the requests of that resource only", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(50 * time.Millisecond)
+			fmt.Fprint(w, `{"data":[]}`)
+		}))
+		defer server.Close()
+
+		baseUrl, _ := url.Parse(server.URL)
+
+		sut, err := NewClient(
+			WithBaseUrl(*baseUrl),
+			WithHttpClient(server.Client()),
+			WithTimeouts(core.Timeouts{PerAttempt: 10 * time.Millisecond}),
+			WithResourceTimeouts(ResourceAccounts, core.ResourceTimeouts{List: core.Timeouts{PerAttempt: time.Second}}),
+		)
+
+		// Act
+		_, accountsErr := sut.Accounts.List(context.Background(), nil)
+		_, paymentsErr := sut.Payments.List(context.Background(), nil)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Nil(t, accountsErr)
+		assert.ErrorIs(t, paymentsErr, context.DeadlineExceeded)
+	})
+
+	t.Run("Given an option to set Timeouts of an unknown Resource should return an error", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithResourceTimeouts("unknown", core.ResourceTimeouts{}),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
 	t.Run("Given an option to set a User Agent should return a client with that specific User Agent", func(t *testing.T) {
 		// Arrange
 		expected := "test/useragent"
@@ -96,4 +176,290 @@ func TestNewClient(t *testing.T) {
 		assert.Equal(t, expected.String() ,actual.baseUrl.String())
 	})
 
-}
\ No newline at end of file
+	t.Run("Given an option to set a Retry Policy should return a client with that specific Retry Policy", func(t *testing.T) {
+		// Arrange
+		expected := core.DefaultRetryPolicy()
+
+		// Act
+		actual, err := NewClient(
+			WithRetryPolicy(expected),
+		)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Same(t, expected, actual.retryPolicy)
+	})
+
+	t.Run("Given an option to set an invalid Retry Policy should return an error", func(t *testing.T) {
+		// Arrange
+		policy := core.DefaultRetryPolicy()
+		policy.MaxAttempts = 0
+
+		// Act
+		actual, err := NewClient(
+			WithRetryPolicy(policy),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given options to add Middlewares should return a client with all the Middlewares in order", func(t *testing.T) {
+		// Arrange
+		first := core.RequestIDMiddleware("", nil)
+		second := core.StaticHeadersMiddleware(http.Header{})
+
+		// Act
+		actual, err := NewClient(
+			WithMiddleware(first),
+			WithMiddleware(second),
+		)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, actual.middlewares, 2)
+	})
+
+	t.Run("Given an option to add a nil Middleware should return an error", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithMiddleware(nil),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given an option to set a Request Signer should return a client with that specific Request Signer", func(t *testing.T) {
+		// Arrange
+		_, key, _ := ed25519.GenerateKey(nil)
+		expected, _ := signing.NewSigner("some_key_id", key)
+
+		// Act
+		actual, err := NewClient(
+			WithRequestSigner(expected),
+		)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Same(t, expected, actual.signer)
+	})
+
+	t.Run("Given an option to set a nil Request Signer should return an error", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithRequestSigner(nil),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given an option to use OAuth2 client credentials should authenticate the resource clients requests", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/oauth2/token" {
+				fmt.Fprint(w, `{"access_token":"some_token","token_type":"Bearer","expires_in":3600}`)
+				return
+			}
+			if r.Header.Get("Authorization") != "Bearer some_token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"data":{"id":"ad27e265-9605-4b4b-a0e5-3003ea9cc4dc"}}`)
+		}))
+		defer server.Close()
+
+		baseUrl, _ := url.Parse(server.URL)
+
+		sut, err := NewClient(
+			WithBaseUrl(*baseUrl),
+			WithHttpClient(server.Client()),
+			WithOAuth2ClientCredentials(server.URL+"/oauth2/token", "some_client", "some_secret", []string{"accounts"}),
+		)
+
+		// Act
+		actual, fetchErr := sut.Accounts.Fetch(context.Background(), uuid.MustParse("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc"))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Nil(t, fetchErr)
+		assert.Equal(t, "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", actual.Data.ID)
+	})
+
+	t.Run("Given an option to use OAuth2 client credentials with an invalid token url should return an error", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithOAuth2ClientCredentials("/relative/token", "some_client", "some_secret", nil),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given an option to validate accounts should not send invalid accounts", func(t *testing.T) {
+		// Arrange
+		sut, err := NewClient(
+			WithAccountValidation(),
+		)
+
+		// Act
+		actual, createErr := sut.Accounts.Create(context.Background(), &models.AccountRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Nil(t, actual)
+		assert.IsType(t, models.ValidationErrors{}, createErr)
+	})
+
+	t.Run("Given an option to set a Rate Limit should return a client with a rate limiter", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithRateLimit(10, 5),
+		)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual.rateLimiter)
+	})
+
+	t.Run("Given an option to set an invalid Rate Limit should return an error", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithAdaptiveRateLimit(0, 1),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given an option to set a Circuit Breaker should return a client with a circuit breaker", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithCircuitBreaker(core.DefaultCircuitBreakerPolicy()),
+		)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual.circuitBreaker)
+	})
+
+	t.Run("Given an option to set a Logger should return a client with that specific Logger", func(t *testing.T) {
+		// Arrange
+		expected := core.NewRequestLogger(nil)
+
+		// Act
+		actual, err := NewClient(
+			WithLogger(expected),
+		)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Same(t, expected, actual.logger)
+	})
+
+	t.Run("Given an option to set a nil Logger should return an error", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithLogger(nil),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given an option to set the Max Response Bytes should fail the responses larger than that", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ad27e265-9605-4b4b-a0e5-3003ea9cc4dc"}}`)
+		}))
+		defer server.Close()
+
+		baseUrl, _ := url.Parse(server.URL)
+
+		sut, err := NewClient(
+			WithBaseUrl(*baseUrl),
+			WithHttpClient(server.Client()),
+			WithMaxResponseBytes(16),
+		)
+
+		// Act
+		actual, fetchErr := sut.Accounts.Fetch(context.Background(), uuid.MustParse("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc"))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, fetchErr, core.ErrResponseTooLarge)
+	})
+
+	t.Run("Given an option to set invalid Max Response Bytes should return an error", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithMaxResponseBytes(0),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given an option to retain the bodies should return a client retaining them", func(t *testing.T) {
+		// Act
+		actual, err := NewClient(
+			WithRetainBody(),
+		)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.True(t, actual.retainBody)
+	})
+
+	t.Run("Given an option to set Telemetry should record a span for each resource client request", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"data":{"id":"ad27e265-9605-4b4b-a0e5-3003ea9cc4dc"}}`)
+		}))
+		defer server.Close()
+
+		baseUrl, _ := url.Parse(server.URL)
+		spans := tracetest.NewSpanRecorder()
+
+		sut, err := NewClient(
+			WithBaseUrl(*baseUrl),
+			WithHttpClient(server.Client()),
+			WithTelemetry(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)), nil),
+		)
+
+		// Act
+		_, fetchErr := sut.Accounts.Fetch(context.Background(), uuid.MustParse("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc"))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Nil(t, fetchErr)
+		assert.NotNil(t, sut.telemetry)
+		assert.Len(t, spans.Ended(), 1)
+		assert.Equal(t, "GET /v1/organisation/accounts/{id}", spans.Ended()[0].Name())
+	})
+
+	t.Run("Given an option to set an invalid Circuit Breaker should return an error", func(t *testing.T) {
+		// Arrange
+		policy := core.DefaultCircuitBreakerPolicy()
+		policy.FailureRatio = 1.5
+
+		// Act
+		actual, err := NewClient(
+			WithCircuitBreaker(policy),
+		)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+}
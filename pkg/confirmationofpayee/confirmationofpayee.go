@@ -0,0 +1,93 @@
+// Package confirmationofpayee provides the client of the Confirmation of Payee API, which checks the name
+// of a payee against the account about to be paid, together with MatchName and CheckName, the local
+// implementation of the matching rules meant for stand-in servers such as fakeapi.
+package confirmationofpayee
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	baseNameChecksPath string = "/v1/confirmation-of-payee/name-checks"
+	nameChecksType     string = "name_checks"
+)
+
+type ConfirmationOfPayeeClient struct {
+	baseClient core.Client
+}
+
+func New(baseClient core.Client) *ConfirmationOfPayeeClient {
+	return &ConfirmationOfPayeeClient{
+		baseClient: baseClient,
+	}
+}
+
+// NewNameCheckRequest returns the request checking name against the account identified by the account number,
+// bank id (e.g. the sort code) and secondary identification of account. The account classification, when set,
+// is checked as the account type. It fails when account is nil.
+func NewNameCheckRequest(organisationID string, account *models.AccountAttributes, name string) (*models.ConfirmationOfPayeeRequest, error) {
+	if account == nil {
+		return nil, fmt.Errorf("account must not be nil")
+	}
+
+	attributes := &models.ConfirmationOfPayeeAttributes{
+		AccountNumber:           account.AccountNumber,
+		BankID:                  account.BankID,
+		BankIDCode:              account.BankIDCode,
+		Name:                    name,
+		SecondaryIdentification: account.SecondaryIdentification,
+	}
+
+	if account.AccountClassification != nil {
+		attributes.AccountType = *account.AccountClassification
+	}
+
+	return &models.ConfirmationOfPayeeRequest{
+		Data: &models.ConfirmationOfPayeeData{
+			Attributes:     attributes,
+			ID:             uuid.NewString(),
+			OrganisationID: organisationID,
+			Type:           nameChecksType,
+		},
+	}, nil
+}
+
+// Create submits the name check and returns it as recorded by the API, with its result.
+// The name check is sent with an idempotency key derived from the one set with core.ContextWithIdempotencyKey, if any,
+// see core.SendIdempotent. As the API answers it with a 200, a conflict is returned as is rather than reconciled.
+func (cc *ConfirmationOfPayeeClient) Create(ctx context.Context, nameCheck *models.ConfirmationOfPayeeRequest) (*models.ConfirmationOfPayeeResponse, error) {
+	nameCheckResponse := &models.ConfirmationOfPayeeResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseNameChecksPath).
+		WithBody(nameCheck)
+
+	if err := core.SendIdempotent(ctx, cc.baseClient, builder, http.StatusOK, nameCheckResponse); err != nil {
+		return nil, err
+	}
+
+	return nameCheckResponse, nil
+}
+
+// Check submits the name check and returns its typed result.
+//
+//	nameCheck, err := confirmationofpayee.NewNameCheckRequest(organisationID, account, "John Smith")
+//	result, err := client.ConfirmationOfPayee.Check(ctx, nameCheck)
+func (cc *ConfirmationOfPayeeClient) Check(ctx context.Context, nameCheck *models.ConfirmationOfPayeeRequest) (*models.NameCheckResult, error) {
+	nameCheckResponse, err := cc.Create(ctx, nameCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	if nameCheckResponse.Data == nil || nameCheckResponse.Data.Attributes == nil || nameCheckResponse.Data.Attributes.Result == nil {
+		return nil, fmt.Errorf("name check response has no result")
+	}
+
+	return nameCheckResponse.Data.Attributes.Result.NameCheckResult(), nil
+}
@@ -0,0 +1,166 @@
+package confirmationofpayee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewNameCheckRequest(t *testing.T) {
+	t.Run("Given account attributes should use their identification and classification", func(t *testing.T) {
+		// Arrange
+		classification := models.AccountTypeBusiness
+		account := &models.AccountAttributes{
+			AccountNumber:           "41426819",
+			BankID:                  "400300",
+			BankIDCode:              "GBDSC",
+			SecondaryIdentification: "ROLL-1",
+			AccountClassification:   &classification,
+		}
+
+		// Act
+		actual, err := NewNameCheckRequest("organisation-id", account, "Acme Ltd")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotEmpty(t, actual.Data.ID)
+		assert.Equal(t, "organisation-id", actual.Data.OrganisationID)
+		assert.Equal(t, nameChecksType, actual.Data.Type)
+		assert.Equal(t, &models.ConfirmationOfPayeeAttributes{
+			AccountNumber:           "41426819",
+			AccountType:             models.AccountTypeBusiness,
+			BankID:                  "400300",
+			BankIDCode:              "GBDSC",
+			Name:                    "Acme Ltd",
+			SecondaryIdentification: "ROLL-1",
+		}, actual.Data.Attributes)
+	})
+
+	t.Run("Given no account should return an error", func(t *testing.T) {
+		// Act
+		actual, err := NewNameCheckRequest("organisation-id", nil, "Acme Ltd")
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Given an error calling base client should return an error", func(t *testing.T) {
+		// Arrange
+		expectedError := fmt.Errorf("Some error occurred")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), &models.ConfirmationOfPayeeRequest{})
+
+		// Assert
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given a name check should send it with an idempotency key", func(t *testing.T) {
+		// Arrange
+		nameCheck, _ := NewNameCheckRequest(uuid.NewString(), &models.AccountAttributes{AccountNumber: "41426819", BankID: "400300"}, "John Smith")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), nameCheck)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, baseNameChecksPath, apiReq.Path)
+		assert.Equal(t, nameCheck, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the name check with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.Create(ctx, &models.ConfirmationOfPayeeRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+baseNameChecksPath, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a response with status code other than 200 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(400), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), &models.ConfirmationOfPayeeRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
+	})
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("Given a response with a result should return the typed result", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil).Run(func(args mock.Arguments) {
+			result := args.Get(0).(*core.Request).Result.(*models.ConfirmationOfPayeeResponse)
+			result.Data = &models.ConfirmationOfPayeeData{Attributes: &models.ConfirmationOfPayeeAttributes{
+				Result: &models.ConfirmationOfPayeeResult{ReasonCode: models.NameCheckCloseMatchCode, ActualName: "John Smith"},
+			}}
+		})
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Check(context.Background(), &models.ConfirmationOfPayeeRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, models.NameCheckCloseMatch, actual.Outcome)
+		assert.Equal(t, "John Smith", actual.SuggestedName)
+	})
+
+	t.Run("Given a response without result should return an error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Check(context.Background(), &models.ConfirmationOfPayeeRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,218 @@
+package confirmationofpayee
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/danimagb/api-client/pkg/models"
+)
+
+const (
+	// minimum similarity between two names, once normalised, for them to be a close match
+	closeMatchSimilarity float64 = 0.8
+)
+
+// Words ignored when looking for a close match.
+var ignoredWords = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "miss": true, "dr": true, "sir": true,
+	"ltd": true, "limited": true, "plc": true, "llp": true, "inc": true,
+}
+
+// MatchName compares the name submitted by the payer with the name of the account.
+// Names differing only in case, punctuation and spacing match. Names differing in titles, company
+// suffixes, word order, initials or a few typos are a close match.
+func MatchName(submitted string, actual string) models.NameCheckOutcome {
+	submittedWords := words(submitted)
+	actualWords := words(actual)
+
+	if len(submittedWords) == 0 || len(actualWords) == 0 {
+		return models.NameCheckNoMatch
+	}
+
+	if strings.Join(submittedWords, " ") == strings.Join(actualWords, " ") {
+		return models.NameCheckMatch
+	}
+
+	submittedWords = significantWords(submittedWords)
+	actualWords = significantWords(actualWords)
+
+	if matchesInitials(submittedWords, actualWords) || matchesInitials(actualWords, submittedWords) {
+		return models.NameCheckCloseMatch
+	}
+
+	sort.Strings(submittedWords)
+	sort.Strings(actualWords)
+
+	if similarity(strings.Join(submittedWords, " "), strings.Join(actualWords, " ")) >= closeMatchSimilarity {
+		return models.NameCheckCloseMatch
+	}
+
+	return models.NameCheckNoMatch
+}
+
+// CheckName checks name against the account the way the Confirmation of Payee service does: the name is matched
+// against the name and the alternative names of the account, and a name matching an account of a different type
+// than accountType is reported as an account type mismatch. An empty accountType is not checked and a nil account
+// is reported as not existing.
+func CheckName(account *models.AccountAttributes, name string, accountType string) *models.NameCheckResult {
+	if account == nil {
+		return &models.NameCheckResult{Outcome: models.NameCheckNoMatch, ReasonCode: models.NameCheckAccountDoesNotExistCode}
+	}
+
+	if account.AccountMatchingOptOut != nil && *account.AccountMatchingOptOut {
+		return &models.NameCheckResult{Outcome: models.NameCheckOptedOut, ReasonCode: models.NameCheckOptedOutCode}
+	}
+
+	outcome, actualName := bestMatch(name, accountNames(account))
+
+	switch outcome {
+	case models.NameCheckNoMatch:
+		return &models.NameCheckResult{Outcome: models.NameCheckNoMatch, ReasonCode: models.NameCheckNoMatchCode}
+	case models.NameCheckCloseMatch:
+		if mismatch, business := accountTypeMismatch(account, accountType); mismatch {
+			code := models.NameCheckPersonalAccountCloseMatchCode
+			if business {
+				code = models.NameCheckBusinessAccountCloseMatchCode
+			}
+			return &models.NameCheckResult{Outcome: models.NameCheckAccountTypeMismatch, ReasonCode: code, SuggestedName: actualName}
+		}
+		return &models.NameCheckResult{Outcome: models.NameCheckCloseMatch, ReasonCode: models.NameCheckCloseMatchCode, SuggestedName: actualName}
+	default:
+		if mismatch, business := accountTypeMismatch(account, accountType); mismatch {
+			code := models.NameCheckPersonalAccountCode
+			if business {
+				code = models.NameCheckBusinessAccountCode
+			}
+			return &models.NameCheckResult{Outcome: models.NameCheckAccountTypeMismatch, ReasonCode: code}
+		}
+		return &models.NameCheckResult{Outcome: models.NameCheckMatch}
+	}
+}
+
+// Returns the best outcome of name against the candidates, with the candidate giving it.
+func bestMatch(name string, candidates []string) (models.NameCheckOutcome, string) {
+	best, bestName := models.NameCheckNoMatch, ""
+
+	for _, candidate := range candidates {
+		switch MatchName(name, candidate) {
+		case models.NameCheckMatch:
+			return models.NameCheckMatch, candidate
+		case models.NameCheckCloseMatch:
+			if best == models.NameCheckNoMatch {
+				best, bestName = models.NameCheckCloseMatch, candidate
+			}
+		}
+	}
+
+	return best, bestName
+}
+
+// The name lines of the account make up a single name, alternative names are names of their own.
+func accountNames(account *models.AccountAttributes) []string {
+	names := []string{}
+	if len(account.Name) > 0 {
+		names = append(names, strings.Join(account.Name, " "))
+	}
+	return append(names, account.AlternativeNames...)
+}
+
+// Reports whether the account is not of accountType, and whether the account is a business account.
+// Accounts without classification are personal accounts.
+func accountTypeMismatch(account *models.AccountAttributes, accountType string) (bool, bool) {
+	classification := models.AccountTypePersonal
+	if account.AccountClassification != nil && *account.AccountClassification != "" {
+		classification = *account.AccountClassification
+	}
+
+	business := strings.EqualFold(classification, models.AccountTypeBusiness)
+
+	return accountType != "" && !strings.EqualFold(accountType, classification), business
+}
+
+// Splits the name into lower case words, dropping punctuation. Apostrophes are removed so that O'Brien is obrien.
+func words(name string) []string {
+	name = strings.ToLower(strings.ReplaceAll(name, "&", " and "))
+
+	return strings.FieldsFunc(strings.ReplaceAll(name, "'", ""), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func significantWords(words []string) []string {
+	significant := []string{}
+	for _, word := range words {
+		if !ignoredWords[word] {
+			significant = append(significant, word)
+		}
+	}
+	return significant
+}
+
+// Reports whether the words of a abbreviate the words of b, e.g. "j smith" for "john smith".
+func matchesInitials(a []string, b []string) bool {
+	if len(a) != len(b) || len(a) == 0 {
+		return false
+	}
+
+	abbreviated := false
+	for i := range a {
+		switch {
+		case a[i] == b[i]:
+		case len([]rune(a[i])) == 1 && strings.HasPrefix(b[i], a[i]):
+			abbreviated = true
+		default:
+			return false
+		}
+	}
+
+	return abbreviated
+}
+
+// Returns the similarity between a and b, from 0 to 1, based on their Levenshtein distance.
+func similarity(a string, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a []rune, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	smallest := values[0]
+	for _, value := range values[1:] {
+		if value < smallest {
+			smallest = value
+		}
+	}
+	return smallest
+}
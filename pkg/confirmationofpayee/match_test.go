@@ -0,0 +1,119 @@
+package confirmationofpayee
+
+import (
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchName(t *testing.T) {
+	testCases := []struct {
+		submitted string
+		actual    string
+		expected  models.NameCheckOutcome
+	}{
+		{"John Smith", "John Smith", models.NameCheckMatch},
+		{"john  SMITH.", "John Smith", models.NameCheckMatch},
+		{"Tom O'Brien", "Tom OBrien", models.NameCheckMatch},
+		{"Mr John Smith", "John Smith", models.NameCheckCloseMatch},
+		{"J Smith", "John Smith", models.NameCheckCloseMatch},
+		{"Smith John", "John Smith", models.NameCheckCloseMatch},
+		{"Jon Smith", "John Smith", models.NameCheckCloseMatch},
+		{"Acme Ltd", "Acme Limited", models.NameCheckCloseMatch},
+		{"Jane Doe", "John Smith", models.NameCheckNoMatch},
+		{"", "John Smith", models.NameCheckNoMatch},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given '"+tc.submitted+"' against '"+tc.actual+"' should return "+string(tc.expected), func(t *testing.T) {
+			// Act
+			actual := MatchName(tc.submitted, tc.actual)
+
+			// Assert
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestCheckName(t *testing.T) {
+	business := models.AccountTypeBusiness
+	optOut := true
+
+	t.Run("Given a name matching an alternative name should return a match", func(t *testing.T) {
+		// Arrange
+		account := &models.AccountAttributes{Name: []string{"Jonathan", "Smith"}, AlternativeNames: []string{"John Smith"}}
+
+		// Act
+		actual := CheckName(account, "John Smith", models.AccountTypePersonal)
+
+		// Assert
+		assert.Equal(t, &models.NameCheckResult{Outcome: models.NameCheckMatch}, actual)
+	})
+
+	t.Run("Given a close match should suggest the account name", func(t *testing.T) {
+		// Arrange
+		account := &models.AccountAttributes{Name: []string{"John", "Smith"}}
+
+		// Act
+		actual := CheckName(account, "Jon Smith", "")
+
+		// Assert
+		assert.Equal(t, models.NameCheckCloseMatch, actual.Outcome)
+		assert.Equal(t, models.NameCheckCloseMatchCode, actual.ReasonCode)
+		assert.Equal(t, "John Smith", actual.SuggestedName)
+	})
+
+	t.Run("Given a matching name on a business account checked as personal should return an account type mismatch", func(t *testing.T) {
+		// Arrange
+		account := &models.AccountAttributes{Name: []string{"Acme Ltd"}, AccountClassification: &business}
+
+		// Act
+		actual := CheckName(account, "Acme Ltd", models.AccountTypePersonal)
+
+		// Assert
+		assert.Equal(t, models.NameCheckAccountTypeMismatch, actual.Outcome)
+		assert.Equal(t, models.NameCheckBusinessAccountCode, actual.ReasonCode)
+	})
+
+	t.Run("Given a close match on an account without classification checked as business should return a personal close match", func(t *testing.T) {
+		// Arrange
+		account := &models.AccountAttributes{Name: []string{"John Smith"}}
+
+		// Act
+		actual := CheckName(account, "J Smith", models.AccountTypeBusiness)
+
+		// Assert
+		assert.Equal(t, models.NameCheckAccountTypeMismatch, actual.Outcome)
+		assert.Equal(t, models.NameCheckPersonalAccountCloseMatchCode, actual.ReasonCode)
+		assert.Equal(t, "John Smith", actual.SuggestedName)
+	})
+
+	t.Run("Given an account opted out should return opted out", func(t *testing.T) {
+		// Arrange
+		account := &models.AccountAttributes{Name: []string{"John Smith"}, AccountMatchingOptOut: &optOut}
+
+		// Act
+		actual := CheckName(account, "John Smith", "")
+
+		// Assert
+		assert.Equal(t, models.NameCheckOptedOut, actual.Outcome)
+	})
+
+	t.Run("Given a different name should return no match", func(t *testing.T) {
+		// Act
+		actual := CheckName(&models.AccountAttributes{Name: []string{"John Smith"}}, "Jane Doe", "")
+
+		// Assert
+		assert.Equal(t, &models.NameCheckResult{Outcome: models.NameCheckNoMatch, ReasonCode: models.NameCheckNoMatchCode}, actual)
+	})
+
+	t.Run("Given no account should return no match as the account does not exist", func(t *testing.T) {
+		// Act
+		actual := CheckName(nil, "John Smith", "")
+
+		// Assert
+		assert.Equal(t, models.NameCheckAccountDoesNotExistCode, actual.ReasonCode)
+		assert.Equal(t, models.NameCheckNoMatch, actual.Outcome)
+	})
+}
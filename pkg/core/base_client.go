@@ -2,8 +2,10 @@ package core
 
 import (
 	"context"
+	"errors"
+	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"net/url"
 	"time"
@@ -24,83 +26,260 @@ const(
 type BaseClient struct {
 	BaseUrl	url.URL
 	UserAgent  string
+	// Deprecated: Timeout is the per attempt budget in milliseconds. Use Timeouts instead, which take precedence.
 	Timeout	int
+	Timeouts *Timeouts
 	HttpClient HTTPClient
+	RetryPolicy *RetryPolicy
+	Middlewares []Middleware
+	Signer RequestSigner
+	RateLimiter *RateLimiter
+	CircuitBreaker *CircuitBreaker
+	// MaxResponseBytes limits the size of the response bodies, DefaultMaxResponseBytes when 0 and unlimited when negative.
+	MaxResponseBytes int64
+	// RetainBody keeps the raw body of every response for Response.Body, rather than of the small ones only.
+	RetainBody bool
+	Telemetry *Telemetry
+	Logger *RequestLogger
+}
+
+// RequestSigner authenticates an http request, e.g. by adding a signature header.
+// It runs right before the request is sent, after every middleware.
+type RequestSigner interface {
+	SignRequest(httpReq *http.Request) error
 }
 
 // Send makes the http request and returns a Response or error.
+// When a RetryPolicy is set, failed attempts are retried while the request context allows it.
+// When Telemetry is set, the call and all its attempts are recorded as a single span.
 func (c *BaseClient) Send(apiReq *Request) (*Response, error) {
+	ctx, observation := c.Telemetry.start(apiReq.getContext(), apiReq)
+
+	timeouts := c.timeoutsFor(ctx, apiReq)
+	if timeouts.Operation > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeouts.Operation)
+		defer cancel()
+	}
+
+	apiResponse, err := c.send(ctx, apiReq, timeouts, observation)
+
+	observation.end(apiResponse, err)
+
+	return apiResponse, err
+}
 
+func (c *BaseClient) send(ctx context.Context, apiReq *Request, timeouts Timeouts, observation *observation) (*Response, error) {
+	maxAttempts := c.RetryPolicy.attemptsFor(apiReq)
+
+	for attempt := 1; ; attempt++ {
+		observation.attempt(attempt)
+		apiResponse, retryable, err := c.sendAttempt(ctx, apiReq, timeouts)
+		if apiResponse != nil {
+			apiResponse.Attempts = attempt
+		}
+
+		if !retryable || attempt >= maxAttempts {
+			return apiResponse, err
+		}
+
+		if err == nil && !c.RetryPolicy.isRetryableStatus(apiResponse.StatusCode()) {
+			return apiResponse, nil
+		}
+
+		lastResponse := apiResponse
+		if lastResponse == nil {
+			lastResponse = errorResponse(err)
+		}
+
+		delay, ok := c.RetryPolicy.delay(attempt, lastResponse)
+		if !ok {
+			return apiResponse, err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return apiResponse, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, newTransportError(ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// Makes a single attempt of the request, bounded by the attempt timeouts once the rate limiter, if any, lets it through.
+// Reports whether the outcome can be retried, which is the case for transport errors
+// happening while the request context is still alive, for any http response and for the responses
+// with a retryable status code whose body could not be decoded or was too large.
+func (c *BaseClient) sendAttempt(ctx context.Context, apiReq *Request, timeouts Timeouts) (*Response, bool, error) {
 	httpReq, err := apiReq.buildHttpRequest(c.BaseUrl)
 
 	if(err != nil){
-		return nil, fmt.Errorf("Error while creating http request: %v", err)
+		return nil, false, fmt.Errorf("Error while creating http request: %w", err)
 	}
 
 	httpReq.Header.Set("User-Agent", c.UserAgent)
+	c.Telemetry.inject(ctx, httpReq.Header)
 
-	currentContext := apiReq.getContext()
+	key := circuitKey(httpReq)
+	generation, err := c.CircuitBreaker.allow(key)
+	if err != nil {
+		return nil, false, err
+	}
 
-	timeoutToApply := defaultTimeoutInMilliseconds
-	if c.Timeout != 0{
-		timeoutToApply = c.Timeout
+	// waits with the request context, so that the wait does not count towards the attempt timeout
+	if err := c.RateLimiter.Wait(ctx); err != nil {
+		c.CircuitBreaker.done(key, generation, false, true)
+		return nil, false, newTransportError(err)
 	}
 
-	ctx, cancel := context.WithTimeout(currentContext, time.Duration(timeoutToApply)*time.Millisecond)
+	attemptCtx, cancel := withAttemptTimeouts(ctx, timeouts)
 
 	defer cancel()
 
-	httpReq = httpReq.WithContext(ctx)
+	httpReq = httpReq.WithContext(attemptCtx)
 
-	resp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("Error while executing http request: %v", err)
+	apiResponse, err := c.chain(c.roundTrip)(apiReq, httpReq)
+
+	if apiResponse == nil && err == nil {
+		err = errors.New("Error while sending http request: the middlewares returned neither a response nor an error")
 	}
 
+	if apiResponse != nil {
+		c.RateLimiter.Observe(apiResponse.RawResponse)
+	}
 
-	apiResponse, err := c.handleHttpResponse(apiReq, resp)
+	c.CircuitBreaker.done(key, generation, isCircuitFailure(apiResponse, err), ctx.Err() != nil)
 
 	if err != nil{
-		return nil, fmt.Errorf("Error while reading http response body: %v", err)
+		if response := errorResponse(err); response != nil {
+			return nil, c.RetryPolicy.isRetryableStatus(response.StatusCode()), err
+		}
+		return nil, ctx.Err() == nil && isTransportError(err), err
 	}
 
-	return apiResponse, nil
+	return apiResponse, true, nil
 }
 
-//Handles the http response by reading the body and returns a Response or error
-func (c *BaseClient) handleHttpResponse(apiReq *Request, resp *http.Response) (*Response, error) {
-	defer resp.Body.Close()
+// Reports whether the outcome of an attempt tells that the endpoint is unhealthy: a transport error
+// or a 5xx response, even when its body could not be decoded or was too large.
+func isCircuitFailure(apiResponse *Response, err error) bool {
+	if response := errorResponse(err); response != nil {
+		apiResponse = response
+	}
 
-	responseBody, err := ioutil.ReadAll(resp.Body)
+	if apiResponse != nil {
+		return apiResponse.StatusCode() >= http.StatusInternalServerError
+	}
 
+	return isTransportError(err)
+}
+
+// Returns the response carried by a DecodeError or a ResponseTooLargeError, nil for any other error.
+func errorResponse(err error) *Response {
+	var decodeError *DecodeError
+	var tooLargeError *ResponseTooLargeError
+	if errors.As(err, &decodeError) {
+		return decodeError.Response
+	} else if errors.As(err, &tooLargeError) {
+		return tooLargeError.Response
+	}
+	return nil
+}
+
+// Wraps the handler with the client middlewares, the first middleware being the outermost one.
+func (c *BaseClient) chain(handler Handler) Handler {
+	for i := len(c.Middlewares) - 1; i >= 0; i-- {
+		handler = c.Middlewares[i](handler)
+	}
+	return handler
+}
+
+// Executes the http request and handles its response. It is the last handler of the middleware chain,
+// so that the request is logged as it is sent, signature included.
+func (c *BaseClient) roundTrip(apiReq *Request, httpReq *http.Request) (*Response, error) {
+	if c.Signer != nil {
+		if err := c.Signer.SignRequest(httpReq); err != nil {
+			return nil, fmt.Errorf("Error while signing http request: %w", err)
+		}
+	}
+
+	c.Logger.started(apiReq, httpReq)
+	start := time.Now()
+
+	resp, err := c.HttpClient.Do(httpReq)
 	if err != nil {
+		err = newTransportError(phaseTimeoutCause(httpReq.Context(), err))
+		c.Logger.finished(apiReq, httpReq, nil, time.Since(start), err)
 		return nil, err
 	}
 
+	apiResponse, err := c.handleHttpResponse(apiReq, resp)
+	c.Logger.finished(apiReq, httpReq, apiResponse, time.Since(start), err)
+
+	return apiResponse, err
+}
+
+//Handles the http response by streaming its body into the request Result or Error and returns a Response or error
+//Fails with a TransportError when the body cannot be read, with a ResponseTooLargeError when it exceeds
+//the maximum response size and with a DecodeError when the body of a successful response cannot be parsed.
+//An error body that cannot be parsed, e.g. the html page of a gateway, leaves the request Error empty,
+//so that the response is still mapped by its status code, its raw body kept in the Response
+func (c *BaseClient) handleHttpResponse(apiReq *Request, resp *http.Response) (*Response, error) {
+	defer resp.Body.Close()
+
 	apiResponse := &Response{
 		RawResponse: resp,
-		body: responseBody,
 	}
 
-	err = c.parseResponseBody(apiResponse, apiReq.Result, apiReq.Error)
+	body := newBodyReader(resp.Body, c.maxResponseBytes(), c.retainedResponseBytes())
 
-	if(err != nil){
-		return nil , err
+	err := c.parseResponseBody(apiResponse, body, apiReq.Result, apiReq.Error)
+
+	apiResponse.body = body.retained()
+	apiResponse.size = body.size
+
+	if body.tooLarge {
+		return nil, &ResponseTooLargeError{Limit: body.limit, Response: apiResponse}
+	}
+
+	if body.err != nil {
+		return nil, newTransportError(fmt.Errorf("Error while reading http response body: %w", body.err))
+	}
+
+	if(err != nil && !apiResponse.IsError()){
+		return nil , &DecodeError{Err: err, Response: apiResponse}
 	}
 
 	return apiResponse, nil
 }
 
-// Parses the body of the http response
+// Parses the body of the http response while reading it
 // If the response indicates success, parses the body into resultValue
 // If the response does not indicate success, parses the body into errorValue
-func (c *BaseClient) parseResponseBody(apiResponse *Response, resultValue interface{}, errorValue interface{}) error {
+// The rest of the body is read anyway, so that it can be retained and the connection reused
+func (c *BaseClient) parseResponseBody(apiResponse *Response, body io.Reader, resultValue interface{}, errorValue interface{}) error {
+	var target interface{}
 	if apiResponse.IsSuccess() && resultValue != nil{
-		return apiResponse.UnmarshalJson(resultValue);
+		target = resultValue
 
 	} else if apiResponse.IsError() && errorValue != nil{
-		return apiResponse.UnmarshalJson(errorValue)
+		target = errorValue
 	}
 
-	return nil
+	var err error
+	if target != nil{
+		// an empty body leaves the target untouched
+		if err = json.NewDecoder(body).Decode(target); err == io.EOF{
+			err = nil
+		}
+	}
+
+	io.Copy(io.Discard, body)
+
+	return err
 }
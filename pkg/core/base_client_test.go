@@ -2,12 +2,15 @@ package core
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -58,6 +61,7 @@ func TestSend(t *testing.T) {
 
 			expected := &Response{
 				RawResponse: httpResponse,
+				Attempts: 1,
 				body: []byte{},
 			}
 
@@ -98,6 +102,31 @@ func TestSend(t *testing.T) {
 		// Assert
 		assert.NotNil(t, err)
 		assert.Nil(t, actual)
+		assert.IsType(t, &TransportError{}, err)
+		assert.True(t, errors.Is(err, httpError))
+	})
+
+	t.Run("Given httpClient returns a deadline exceeded error should return a TimeoutError", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(nil, fmt.Errorf("Get: %w", context.DeadlineExceeded))
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 1,
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.IsType(t, &TimeoutError{}, err)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
 	})
 
 	t.Run("Given an error building http request should return an error without calling http client Do()", func(t *testing.T) {
@@ -150,12 +179,283 @@ func TestSend(t *testing.T) {
 
 		// Assert
 		assert.NotNil(t, err)
+		assert.IsType(t, &DecodeError{}, err)
 		assert.Nil(t, actual)
 		mockedHttpClient.AssertCalled(t,"Do", mock.Anything)
 	})
 }
 
 
+func TestSendWithRetries(t *testing.T) {
+	url := url.URL{
+		Scheme: "http",
+		Host:   "example.com",
+	}
+
+	policy := &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay: time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	}
+
+	newHttpResponse := func(statusCode int) *http.Response {
+		return &http.Response{StatusCode: statusCode, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}
+	}
+
+	t.Run("Given a retryable status code followed by success should return the successful Response", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(503), nil).Once()
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(200), nil).Once()
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			RetryPolicy: policy,
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 200, actual.StatusCode())
+		assert.Equal(t, 2, actual.Attempts)
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("Given a retryable status code with a body that cannot be decoded followed by success should return the successful Response", func(t *testing.T) {
+		// Arrange
+		type SampleType struct{}
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithErrorWriteTo(new(SampleType)).
+			Build()
+
+		gatewayPage := &http.Response{StatusCode: 503, Body: ioutil.NopCloser(bytes.NewBufferString("<html>Service Unavailable</html>"))}
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(gatewayPage, nil).Once()
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(200), nil).Once()
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			RetryPolicy: policy,
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 200, actual.StatusCode())
+		assert.Equal(t, 2, actual.Attempts)
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("Given a transport error followed by success should recreate the request body for the next attempt", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodPut).
+			WithBody("sample body").
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(nil, fmt.Errorf("connection reset")).Once()
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(200), nil).Once()
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			RetryPolicy: policy,
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 200, actual.StatusCode())
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 2)
+		for _, call := range mockedHttpClient.Calls {
+			body, _ := ioutil.ReadAll(call.Arguments.Get(0).(*http.Request).Body)
+			assert.Equal(t, `"sample body"`, string(body))
+		}
+	})
+
+	t.Run("Given retryable status codes on every attempt should return the last Response", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(503), nil).Times(3)
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			RetryPolicy: policy,
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 503, actual.StatusCode())
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 3)
+	})
+
+	t.Run("Given a non idempotent request without idempotency key should not retry", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodPost).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(503), nil)
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			RetryPolicy: policy,
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 503, actual.StatusCode())
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("Given a non idempotent request with idempotency key should retry with the same key", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodPost).
+			WithIdempotencyKey("some_key").
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(nil, fmt.Errorf("connection reset")).Once()
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(201), nil).Once()
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			RetryPolicy: policy,
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 201, actual.StatusCode())
+		assert.Equal(t, 2, actual.Attempts)
+		for _, call := range mockedHttpClient.Calls {
+			assert.Equal(t, "some_key", call.Arguments.Get(0).(*http.Request).Header.Get(IdempotencyKeyHeader))
+		}
+	})
+
+	t.Run("Given a non retryable status code should not retry", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(404), nil)
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			RetryPolicy: policy,
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 404, actual.StatusCode())
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("Given a Retry-After longer than the max delay should return the Response without retrying", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		httpResponse := newHttpResponse(429)
+		httpResponse.Header = http.Header{"Retry-After": {"3600"}}
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(httpResponse, nil)
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			RetryPolicy: &RetryPolicy{
+				MaxAttempts: 3,
+				BaseDelay: time.Millisecond,
+				MaxDelay: time.Second,
+				RetryableStatusCodes: []int{http.StatusTooManyRequests},
+				RespectRetryAfter: true,
+			},
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 429, actual.StatusCode())
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("Given a context deadline shorter than the retry delay should not retry", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithContext(ctx).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(503), nil)
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			RetryPolicy: &RetryPolicy{
+				MaxAttempts: 3,
+				BaseDelay: time.Second,
+				RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+			},
+		}
+
+		// Act
+		actual, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 503, actual.StatusCode())
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+}
+
 func TestHandleHttpResponse(t *testing.T) {
 	t.Run("Given a valid Http Response should return Response", func(t *testing.T) {
 		// Arrange
@@ -200,6 +500,32 @@ func TestHandleHttpResponse(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, actual)
 	})
+
+	t.Run("Given an error body that cannot be decoded should return the Response with the raw body", func(t *testing.T) {
+		// Arrange
+		type SampleType struct{
+			Message string
+		}
+
+		errorValue := new(SampleType)
+
+		apiReq := NewRequestBuilder("GET").
+			WithErrorWriteTo(errorValue).
+			Build()
+
+		httpResponse := &http.Response{StatusCode: 503, Body: ioutil.NopCloser(bytes.NewBufferString("<html>Service Unavailable</html>"))}
+
+		sut := &BaseClient{}
+
+		// Act
+		actual, err := sut.handleHttpResponse(apiReq, httpResponse)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 503, actual.StatusCode())
+		assert.Equal(t, "<html>Service Unavailable</html>", string(actual.Body()))
+		assert.Empty(t, errorValue.Message)
+	})
 }
 
 
@@ -230,7 +556,7 @@ func TestParseResponseBody(t *testing.T) {
 
 		// Act
 		actual := &SampleType{}
-		err := sut.parseResponseBody(apiResponse, actual, nil)
+		err := sut.parseResponseBody(apiResponse, bytes.NewReader(responseBody), actual, nil)
 
 		// Assert
 		assert.Nil(t, err)
@@ -263,7 +589,7 @@ func TestParseResponseBody(t *testing.T) {
 
 		// Act
 		actual := &SampleType{}
-		err := sut.parseResponseBody(apiResponse, nil, actual)
+		err := sut.parseResponseBody(apiResponse, bytes.NewReader(responseBody), nil, actual)
 
 		// Assert
 		assert.Nil(t, err)
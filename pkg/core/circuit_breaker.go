@@ -0,0 +1,257 @@
+package core
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of the circuit of an endpoint.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every request through, counting the failures.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails every request with a *CircuitOpenError until the cool-down is over.
+	CircuitOpen
+	// CircuitHalfOpen lets a few probe requests through, closing the circuit when they succeed
+	// and opening it again when one fails.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+var (
+	uuidSegment    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	numericSegment = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// PathTemplate replaces the uuid and numeric segments of the path with {id}, so that all the requests
+// to an endpoint share the same template, e.g. /v1/organisation/accounts/{id}.
+func PathTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if uuidSegment.MatchString(segment) || numericSegment.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// CircuitBreakerPolicy describes when the circuit of an endpoint opens and how it recovers.
+type CircuitBreakerPolicy struct {
+	// FailureRatio is the ratio (between 0 and 1) of failed requests over Window that opens the circuit.
+	FailureRatio float64
+	// MinimumRequests is the number of requests over Window below which the circuit never opens.
+	MinimumRequests int
+	// Window is the period over which the requests are counted. Counts start over at the end of every window.
+	Window time.Duration
+	// CoolDown is how long the circuit stays open before letting probe requests through.
+	CoolDown time.Duration
+	// HalfOpenRequests is the number of successful probe requests that close the circuit again.
+	HalfOpenRequests int
+	// OnStateChange, when set, is called every time the circuit of an endpoint changes state.
+	// It is called synchronously by the request causing the change, so it should not block.
+	OnStateChange func(key string, from CircuitState, to CircuitState)
+}
+
+// DefaultCircuitBreakerPolicy returns a policy opening the circuit of an endpoint when half of at least
+// 10 requests over 30s fail, and probing it again after 15s.
+func DefaultCircuitBreakerPolicy() *CircuitBreakerPolicy {
+	return &CircuitBreakerPolicy{
+		FailureRatio:     0.5,
+		MinimumRequests:  10,
+		Window:           30 * time.Second,
+		CoolDown:         15 * time.Second,
+		HalfOpenRequests: 1,
+	}
+}
+
+// CircuitBreaker fails fast the requests to the endpoints that keep failing, instead of letting them wait for
+// their timeout. Each endpoint, identified by its method, host and path template, has a circuit of its own.
+// Transport errors and 5xx responses count as failures, while requests whose own context is done are ignored.
+// It is safe for concurrent use.
+type CircuitBreaker struct {
+	policy   CircuitBreakerPolicy
+	mu       sync.Mutex
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+type stateChange struct {
+	key  string
+	from CircuitState
+	to   CircuitState
+}
+
+type circuit struct {
+	state       CircuitState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	// probes in flight and succeeded while half-open
+	probes    int
+	successes int
+	// counts the state changes, so that the outcomes of the requests allowed before the last one are told apart
+	generation uint64
+}
+
+func NewCircuitBreaker(policy *CircuitBreakerPolicy) *CircuitBreaker {
+	return &CircuitBreaker{
+		policy:   *policy,
+		circuits: map[string]*circuit{},
+		now:      time.Now,
+	}
+}
+
+// State returns the state of the circuit of the endpoint identified by key.
+func (b *CircuitBreaker) State(key string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c, ok := b.circuits[key]; ok {
+		return c.state
+	}
+	return CircuitClosed
+}
+
+// Returns the key of the circuit of the request.
+func circuitKey(httpReq *http.Request) string {
+	return httpReq.Method + " " + httpReq.URL.Host + PathTemplate(httpReq.URL.Path)
+}
+
+// allow returns a *CircuitOpenError when the request must not be sent. Every allowed request must be followed
+// by a call to done with the returned generation of the circuit.
+func (b *CircuitBreaker) allow(key string) (uint64, error) {
+	if b == nil {
+		return 0, nil
+	}
+
+	var changes []stateChange
+	defer func() { b.notify(changes) }()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuit(key)
+	now := b.now()
+
+	if c.state == CircuitOpen {
+		retryAt := c.openedAt.Add(b.policy.CoolDown)
+		if now.Before(retryAt) {
+			return 0, &CircuitOpenError{Key: key, RetryAt: retryAt}
+		}
+		changes = append(changes, b.transition(key, c, CircuitHalfOpen))
+	}
+
+	if c.state == CircuitHalfOpen {
+		if c.probes+c.successes >= b.probesAllowed() {
+			return 0, &CircuitOpenError{Key: key, RetryAt: now}
+		}
+		c.probes++
+	}
+
+	return c.generation, nil
+}
+
+// done records the outcome of a request allowed in the given generation of the circuit. Outcomes that do not tell
+// about the health of the endpoint, such as the caller cancelling the request, are ignored, as are the outcomes
+// of the requests allowed before the circuit last changed state, e.g. a request sent while closed that completes
+// once half-open is not a probe.
+func (b *CircuitBreaker) done(key string, generation uint64, failed bool, ignored bool) {
+	if b == nil {
+		return
+	}
+
+	var changes []stateChange
+	defer func() { b.notify(changes) }()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuit(key)
+	now := b.now()
+
+	if generation != c.generation {
+		return
+	}
+
+	switch c.state {
+	case CircuitHalfOpen:
+		c.probes--
+		switch {
+		case ignored:
+		case failed:
+			c.openedAt = now
+			changes = append(changes, b.transition(key, c, CircuitOpen))
+		default:
+			c.successes++
+			if c.successes >= b.probesAllowed() {
+				c.windowStart, c.requests, c.failures = now, 0, 0
+				changes = append(changes, b.transition(key, c, CircuitClosed))
+			}
+		}
+	case CircuitClosed:
+		if ignored {
+			return
+		}
+		if now.Sub(c.windowStart) >= b.policy.Window {
+			c.windowStart, c.requests, c.failures = now, 0, 0
+		}
+		c.requests++
+		if failed {
+			c.failures++
+		}
+		if c.requests >= b.policy.MinimumRequests && float64(c.failures) >= b.policy.FailureRatio*float64(c.requests) {
+			c.openedAt = now
+			changes = append(changes, b.transition(key, c, CircuitOpen))
+		}
+	}
+}
+
+func (b *CircuitBreaker) circuit(key string) *circuit {
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{state: CircuitClosed, windowStart: b.now()}
+		b.circuits[key] = c
+	}
+	return c
+}
+
+func (b *CircuitBreaker) transition(key string, c *circuit, to CircuitState) stateChange {
+	change := stateChange{key: key, from: c.state, to: to}
+	c.state = to
+	c.probes, c.successes = 0, 0
+	c.generation++
+	return change
+}
+
+// Calls OnStateChange for the changes, once the lock is released so that it can query the breaker.
+func (b *CircuitBreaker) notify(changes []stateChange) {
+	if b.policy.OnStateChange == nil {
+		return
+	}
+	for _, change := range changes {
+		b.policy.OnStateChange(change.key, change.from, change.to)
+	}
+}
+
+func (b *CircuitBreaker) probesAllowed() int {
+	if b.policy.HalfOpenRequests < 1 {
+		return 1
+	}
+	return b.policy.HalfOpenRequests
+}
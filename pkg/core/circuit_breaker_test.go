@@ -0,0 +1,299 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestCircuitBreaker(policy *CircuitBreakerPolicy) (*CircuitBreaker, func(time.Duration)) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(policy)
+	breaker.now = func() time.Time { return now }
+	return breaker, func(d time.Duration) { now = now.Add(d) }
+}
+
+func testPolicy() *CircuitBreakerPolicy {
+	return &CircuitBreakerPolicy{
+		FailureRatio:     0.5,
+		MinimumRequests:  4,
+		Window:           time.Minute,
+		CoolDown:         10 * time.Second,
+		HalfOpenRequests: 1,
+	}
+}
+
+// Sends requests with the given outcomes through the breaker.
+func record(b *CircuitBreaker, key string, failures ...bool) {
+	for _, failed := range failures {
+		if generation, err := b.allow(key); err == nil {
+			b.done(key, generation, failed, false)
+		}
+	}
+}
+
+func TestPathTemplate(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{"/v1/organisation/accounts", "/v1/organisation/accounts"},
+		{"/v1/organisation/accounts/ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", "/v1/organisation/accounts/{id}"},
+		{"/v1/transaction/payments/ad27e265-9605-4b4b-a0e5-3003ea9cc4dc/returns/42", "/v1/transaction/payments/{id}/returns/{id}"},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given "+tc.path+" should return "+tc.expected, func(t *testing.T) {
+			// Act
+			actual := PathTemplate(tc.path)
+
+			// Assert
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("Given failures below the minimum requests should stay closed", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestCircuitBreaker(testPolicy())
+
+		// Act
+		record(sut, "GET /accounts", true, true, true)
+
+		// Assert
+		assert.Equal(t, CircuitClosed, sut.State("GET /accounts"))
+		_, err := sut.allow("GET /accounts")
+		assert.Nil(t, err)
+	})
+
+	t.Run("Given the failure ratio reached should open and fail fast", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestCircuitBreaker(testPolicy())
+
+		// Act
+		record(sut, "GET /accounts", false, true, false, true)
+		_, err := sut.allow("GET /accounts")
+		_, otherErr := sut.allow("GET /payments")
+
+		// Assert
+		assert.Equal(t, CircuitOpen, sut.State("GET /accounts"))
+		assert.True(t, errors.Is(err, ErrCircuitOpen))
+		var circuitOpenErr *CircuitOpenError
+		assert.True(t, errors.As(err, &circuitOpenErr))
+		assert.Equal(t, "GET /accounts", circuitOpenErr.Key)
+		assert.Nil(t, otherErr)
+	})
+
+	t.Run("Given failures spread over several windows should stay closed", func(t *testing.T) {
+		// Arrange
+		sut, advance := newTestCircuitBreaker(testPolicy())
+
+		// Act
+		record(sut, "GET /accounts", true, true, false)
+		advance(2 * time.Minute)
+		record(sut, "GET /accounts", true, false)
+
+		// Assert
+		assert.Equal(t, CircuitClosed, sut.State("GET /accounts"))
+	})
+
+	t.Run("Given the cool-down over should let a single probe through and close on its success", func(t *testing.T) {
+		// Arrange
+		changes := []string{}
+		policy := testPolicy()
+		policy.OnStateChange = func(key string, from CircuitState, to CircuitState) {
+			changes = append(changes, fmt.Sprintf("%s->%s", from, to))
+		}
+		sut, advance := newTestCircuitBreaker(policy)
+		record(sut, "GET /accounts", true, true, true, true)
+		advance(10 * time.Second)
+
+		// Act
+		generation, probeErr := sut.allow("GET /accounts")
+		_, concurrentErr := sut.allow("GET /accounts")
+		sut.done("GET /accounts", generation, false, false)
+
+		// Assert
+		assert.Nil(t, probeErr)
+		assert.True(t, errors.Is(concurrentErr, ErrCircuitOpen))
+		assert.Equal(t, CircuitClosed, sut.State("GET /accounts"))
+		assert.Equal(t, []string{"closed->open", "open->half-open", "half-open->closed"}, changes)
+	})
+
+	t.Run("Given a failed probe should open again", func(t *testing.T) {
+		// Arrange
+		sut, advance := newTestCircuitBreaker(testPolicy())
+		record(sut, "GET /accounts", true, true, true, true)
+		advance(10 * time.Second)
+
+		// Act
+		generation, _ := sut.allow("GET /accounts")
+		sut.done("GET /accounts", generation, true, false)
+		_, err := sut.allow("GET /accounts")
+
+		// Assert
+		assert.Equal(t, CircuitOpen, sut.State("GET /accounts"))
+		assert.True(t, errors.Is(err, ErrCircuitOpen))
+	})
+
+	t.Run("Given an ignored probe should let another probe through", func(t *testing.T) {
+		// Arrange
+		sut, advance := newTestCircuitBreaker(testPolicy())
+		record(sut, "GET /accounts", true, true, true, true)
+		advance(10 * time.Second)
+
+		// Act
+		generation, _ := sut.allow("GET /accounts")
+		sut.done("GET /accounts", generation, false, true)
+		_, err := sut.allow("GET /accounts")
+
+		// Assert
+		assert.Equal(t, CircuitHalfOpen, sut.State("GET /accounts"))
+		assert.Nil(t, err)
+	})
+
+	t.Run("Given a request allowed while closed completing once half-open should not count as a probe", func(t *testing.T) {
+		// Arrange
+		sut, advance := newTestCircuitBreaker(testPolicy())
+		inFlight, _ := sut.allow("GET /accounts")
+		record(sut, "GET /accounts", true, true, true, true)
+		advance(10 * time.Second)
+
+		probe, _ := sut.allow("GET /accounts")
+
+		// Act
+		sut.done("GET /accounts", inFlight, true, false)
+		_, concurrentErr := sut.allow("GET /accounts")
+		sut.done("GET /accounts", probe, false, false)
+
+		// Assert
+		assert.True(t, errors.Is(concurrentErr, ErrCircuitOpen))
+		assert.Equal(t, CircuitClosed, sut.State("GET /accounts"))
+	})
+
+	t.Run("Given a probe of an earlier half-open state completing should not count as a probe", func(t *testing.T) {
+		// Arrange
+		policy := testPolicy()
+		policy.HalfOpenRequests = 2
+		sut, advance := newTestCircuitBreaker(policy)
+		record(sut, "GET /accounts", true, true, true, true)
+		advance(10 * time.Second)
+
+		staleProbe, _ := sut.allow("GET /accounts")
+		failedProbe, _ := sut.allow("GET /accounts")
+		sut.done("GET /accounts", failedProbe, true, false)
+		advance(10 * time.Second)
+
+		probe, _ := sut.allow("GET /accounts")
+
+		// Act
+		sut.done("GET /accounts", staleProbe, false, false)
+		_, secondProbeErr := sut.allow("GET /accounts")
+		sut.done("GET /accounts", probe, false, false)
+
+		// Assert
+		assert.Nil(t, secondProbeErr)
+		assert.Equal(t, CircuitHalfOpen, sut.State("GET /accounts"))
+	})
+}
+
+func TestSendWithCircuitBreaker(t *testing.T) {
+	t.Run("Given an endpoint returning server errors should fail fast once the circuit is open", func(t *testing.T) {
+		// Arrange
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: 503, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}, nil).Once()
+		mockedHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: 503, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}, nil).Once()
+
+		policy := testPolicy()
+		policy.MinimumRequests = 2
+
+		sut := &BaseClient{
+			BaseUrl:        url.URL{Scheme: "http", Host: "example.com"},
+			HttpClient:     mockedHttpClient,
+			CircuitBreaker: NewCircuitBreaker(policy),
+		}
+
+		send := func(id string) error {
+			_, err := sut.Send(NewRequestBuilder(http.MethodGet).WithPath("/v1/organisation/accounts").WithPath(id).Build())
+			return err
+		}
+
+		// Act
+		send("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc")
+		send("0d209d7f-d07a-4542-947f-5885fddddae2")
+		err := send("eb0bd6f5-c3f5-44b2-b677-acd23cdde73c")
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrCircuitOpen))
+		assert.Equal(t, CircuitOpen, sut.CircuitBreaker.State("GET example.com/v1/organisation/accounts/{id}"))
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("Given requests cancelled by the caller should not open the circuit", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(nil, context.Canceled)
+
+		policy := testPolicy()
+		policy.MinimumRequests = 1
+
+		sut := &BaseClient{
+			BaseUrl:        url.URL{Scheme: "http", Host: "example.com"},
+			HttpClient:     mockedHttpClient,
+			CircuitBreaker: NewCircuitBreaker(policy),
+		}
+
+		// Act
+		sut.Send(NewRequestBuilder(http.MethodGet).WithPath("/v1/health").WithContext(ctx).Build())
+
+		// Assert
+		assert.Equal(t, CircuitClosed, sut.CircuitBreaker.State("GET example.com/v1/health"))
+	})
+}
+
+func TestIsCircuitFailure(t *testing.T) {
+	newResponse := func(statusCode int) *Response {
+		return &Response{RawResponse: &http.Response{StatusCode: statusCode}}
+	}
+
+	testCases := []struct {
+		name     string
+		response *Response
+		err      error
+		expected bool
+	}{
+		{"a successful response", newResponse(200), nil, false},
+		{"a 404 response", newResponse(404), nil, false},
+		{"a 503 response", newResponse(503), nil, true},
+		{"a transport error", nil, &TransportError{Err: errors.New("connection reset")}, true},
+		{"a timeout", nil, &TimeoutError{Err: context.DeadlineExceeded}, true},
+		{"a 503 response that could not be decoded", nil, &DecodeError{Err: errors.New("invalid json"), Response: newResponse(503)}, true},
+		{"a 200 response that could not be decoded", nil, &DecodeError{Err: errors.New("invalid json"), Response: newResponse(200)}, false},
+		{"a 503 response too large", nil, &ResponseTooLargeError{Limit: 10, Response: newResponse(503)}, true},
+		{"a 200 response too large", nil, &ResponseTooLargeError{Limit: 10, Response: newResponse(200)}, false},
+		{"any other error", nil, errors.New("signing failed"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Given %s should return %t", tc.name, tc.expected), func(t *testing.T) {
+			// Act
+			actual := isCircuitFailure(tc.response, tc.err)
+
+			// Assert
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
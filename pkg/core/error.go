@@ -1,7 +1,28 @@
 package core
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors matched by the API errors through errors.Is.
+var (
+	ErrBadRequest       = errors.New("bad request")
+	ErrNotFound         = errors.New("not found")
+	ErrConflict         = errors.New("conflict")
+	ErrRateLimited      = errors.New("rate limited")
+	ErrServerError      = errors.New("server error")
+	ErrCircuitOpen      = errors.New("circuit open")
+	ErrResponseTooLarge = errors.New("response too large")
+)
+
+const(
+	unsuccessfulStatusReason string = "Status code does not represent success for this request"
 )
 
 
@@ -23,4 +44,174 @@ func NewApiClientError(reason string, statusCode int, message string, response *
 
 func (clientError *ApiClientError) Error() string {
 	return fmt.Sprintf("%s (Status Code: %d | Message: '%s')", clientError.Reason, clientError.StatusCode, clientError.Message)
-}
\ No newline at end of file
+}
+
+// NewErrorFromResponse maps an unsuccessful response into the API error specialised by its status code.
+// Every returned error can be unwrapped into an *ApiClientError.
+func NewErrorFromResponse(response *Response, message string) error {
+	apiError := NewApiClientError(unsuccessfulStatusReason, response.StatusCode(), message, response)
+
+	switch statusCode := response.StatusCode(); {
+	case statusCode == http.StatusBadRequest:
+		return &BadRequestError{ApiClientError: apiError, Details: parseValidationDetails(message)}
+	case statusCode == http.StatusNotFound:
+		return &NotFoundError{ApiClientError: apiError}
+	case statusCode == http.StatusConflict:
+		return &ConflictError{ApiClientError: apiError}
+	case statusCode == http.StatusTooManyRequests:
+		rateLimitedError := &RateLimitedError{ApiClientError: apiError}
+		if response.RawResponse != nil {
+			rateLimitedError.RetryAfter, _ = parseRetryAfter(response.RawResponse.Header.Get("Retry-After"), time.Now())
+		}
+		return rateLimitedError
+	case statusCode >= http.StatusInternalServerError:
+		return &ServerError{ApiClientError: apiError}
+	}
+
+	return apiError
+}
+
+// BadRequestError is returned for 400 responses. Details holds each validation failure reported by the API.
+type BadRequestError struct {
+	*ApiClientError
+	Details []string
+}
+
+func (e *BadRequestError) Unwrap() error { return e.ApiClientError }
+
+func (e *BadRequestError) Is(target error) bool { return target == ErrBadRequest }
+
+// NotFoundError is returned for 404 responses.
+type NotFoundError struct {
+	*ApiClientError
+}
+
+func (e *NotFoundError) Unwrap() error { return e.ApiClientError }
+
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// ConflictError is returned for 409 responses, e.g. a version mismatch or a duplicate id.
+type ConflictError struct {
+	*ApiClientError
+}
+
+func (e *ConflictError) Unwrap() error { return e.ApiClientError }
+
+func (e *ConflictError) Is(target error) bool { return target == ErrConflict }
+
+// RateLimitedError is returned for 429 responses. RetryAfter is zero when the response has no Retry-After header.
+type RateLimitedError struct {
+	*ApiClientError
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Unwrap() error { return e.ApiClientError }
+
+func (e *RateLimitedError) Is(target error) bool { return target == ErrRateLimited }
+
+// ServerError is returned for 5xx responses.
+type ServerError struct {
+	*ApiClientError
+}
+
+func (e *ServerError) Unwrap() error { return e.ApiClientError }
+
+func (e *ServerError) Is(target error) bool { return target == ErrServerError }
+
+// TransportError is returned when the http request could not be executed or its response could not be read.
+type TransportError struct {
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("Error while executing http request: %v", e.Err)
+}
+
+func (e *TransportError) Unwrap() error { return e.Err }
+
+// TimeoutError is returned when the request did not complete before its deadline.
+// It always matches context.DeadlineExceeded through errors.Is.
+type TimeoutError struct {
+	Err error
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("Timeout while executing http request: %v", e.Err)
+}
+
+func (e *TimeoutError) Unwrap() error { return e.Err }
+
+func (e *TimeoutError) Is(target error) bool { return target == context.DeadlineExceeded }
+
+// Timeout allows TimeoutError to be identified as a net.Error timeout.
+func (e *TimeoutError) Timeout() bool { return true }
+
+// DecodeError is returned when the body of a successful response could not be decoded.
+type DecodeError struct {
+	Err      error
+	Response *Response
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("Error while decoding http response body: %v", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error { return e.Err }
+
+// CircuitOpenError is returned without sending the request when the circuit breaker of its endpoint is open.
+// RetryAt is the time the breaker lets a request through again to probe the endpoint.
+type CircuitOpenError struct {
+	Key     string
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("Circuit open for %s until %s", e.Key, e.RetryAt.Format(time.RFC3339))
+}
+
+func (e *CircuitOpenError) Is(target error) bool { return target == ErrCircuitOpen }
+
+// ResponseTooLargeError is returned when the response body is larger than the maximum response size.
+// Response holds the status and headers of the response, its body being left undecoded.
+type ResponseTooLargeError struct {
+	Limit    int64
+	Response *Response
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("Response body larger than the maximum response size of %d bytes", e.Limit)
+}
+
+func (e *ResponseTooLargeError) Is(target error) bool { return target == ErrResponseTooLarge }
+
+// newTransportError wraps an error raised while talking to the server,
+// telling timeouts apart from other transport failures.
+func newTransportError(err error) error {
+	var netError net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netError) && netError.Timeout()) {
+		return &TimeoutError{Err: err}
+	}
+	return &TransportError{Err: err}
+}
+
+// isTransportError reports whether the error was raised while talking to the server.
+func isTransportError(err error) bool {
+	var transportError *TransportError
+	var timeoutError *TimeoutError
+	return errors.As(err, &transportError) || errors.As(err, &timeoutError)
+}
+
+// parseValidationDetails splits the message of a 400 response into each of the validation failures it lists.
+func parseValidationDetails(message string) []string {
+	var details []string
+
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "validation failure list") {
+			continue
+		}
+		details = append(details, line)
+	}
+
+	return details
+}
@@ -1,7 +1,12 @@
 package core
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,3 +26,104 @@ func TestNewApiClientError(t *testing.T) {
 	// Assert
 	assert.Equal(t, expected, actual)
 }
+
+func TestNewErrorFromResponse(t *testing.T) {
+	testCases := []struct {
+		statusCode   int
+		expectedType error
+		sentinel     error
+	}{
+		{http.StatusBadRequest, &BadRequestError{}, ErrBadRequest},
+		{http.StatusNotFound, &NotFoundError{}, ErrNotFound},
+		{http.StatusConflict, &ConflictError{}, ErrConflict},
+		{http.StatusTooManyRequests, &RateLimitedError{}, ErrRateLimited},
+		{http.StatusInternalServerError, &ServerError{}, ErrServerError},
+		{http.StatusServiceUnavailable, &ServerError{}, ErrServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given an unsuccessful status code should return the respective API error", func(t *testing.T) {
+			// Arrange
+			response := &Response{RawResponse: &http.Response{StatusCode: tc.statusCode, Header: http.Header{}}}
+
+			// Act
+			actual := NewErrorFromResponse(response, "some_message")
+
+			// Assert
+			assert.IsType(t, tc.expectedType, actual)
+			assert.True(t, errors.Is(actual, tc.sentinel))
+
+			var apiError *ApiClientError
+			assert.True(t, errors.As(actual, &apiError))
+			assert.Equal(t, tc.statusCode, apiError.StatusCode)
+			assert.Equal(t, "some_message", apiError.Message)
+		})
+	}
+
+	t.Run("Given a status code without specialised error should return an ApiClientError", func(t *testing.T) {
+		// Arrange
+		response := &Response{RawResponse: &http.Response{StatusCode: http.StatusForbidden}}
+
+		// Act
+		actual := NewErrorFromResponse(response, "some_message")
+
+		// Assert
+		assert.IsType(t, &ApiClientError{}, actual)
+		assert.False(t, errors.Is(actual, ErrNotFound))
+	})
+
+	t.Run("Given a 400 response with validation failures should return them as details", func(t *testing.T) {
+		// Arrange
+		response := &Response{RawResponse: &http.Response{StatusCode: http.StatusBadRequest}}
+		message := "validation failure list:\nvalidation failure list:\ncountry in body is required\nid in body is required"
+
+		// Act
+		actual := NewErrorFromResponse(response, message)
+
+		// Assert
+		var badRequestError *BadRequestError
+		assert.True(t, errors.As(actual, &badRequestError))
+		assert.Equal(t, []string{"country in body is required", "id in body is required"}, badRequestError.Details)
+	})
+
+	t.Run("Given a 429 response with Retry-After should return it in the error", func(t *testing.T) {
+		// Arrange
+		httpResponse := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		httpResponse.Header.Set("Retry-After", "3")
+
+		// Act
+		actual := NewErrorFromResponse(&Response{RawResponse: httpResponse}, "")
+
+		// Assert
+		var rateLimitedError *RateLimitedError
+		assert.True(t, errors.As(actual, &rateLimitedError))
+		assert.Equal(t, 3*time.Second, rateLimitedError.RetryAfter)
+	})
+}
+
+func TestNewTransportError(t *testing.T) {
+	t.Run("Given a deadline exceeded error should return a TimeoutError", func(t *testing.T) {
+		// Arrange
+		err := &url.Error{Op: "Get", URL: "http://example.com", Err: context.DeadlineExceeded}
+
+		// Act
+		actual := newTransportError(err)
+
+		// Assert
+		assert.IsType(t, &TimeoutError{}, actual)
+		assert.True(t, errors.Is(actual, context.DeadlineExceeded))
+	})
+
+	t.Run("Given any other error should return a TransportError wrapping it", func(t *testing.T) {
+		// Arrange
+		err := &url.Error{Op: "Get", URL: "http://example.com", Err: context.Canceled}
+
+		// Act
+		actual := newTransportError(err)
+
+		// Assert
+		assert.IsType(t, &TransportError{}, actual)
+		assert.True(t, errors.Is(actual, context.Canceled))
+		assert.False(t, errors.Is(actual, context.DeadlineExceeded))
+	})
+}
@@ -0,0 +1,35 @@
+package core
+
+import "strconv"
+
+// PageOptions holds the paging parameters of the list operations of the resource clients.
+// Zero values are not sent, leaving the API defaults in place.
+type PageOptions struct {
+	PageNumber int
+	PageSize   int
+}
+
+// ListFilters maps the filter query parameters of a list operation, e.g. "filter[status]", to their values.
+type ListFilters map[string][]string
+
+// ApplyList adds the paging parameters and the filters to the query of the request built so far.
+// Every value of a filter is sent as its own query parameter, and empty values are not sent.
+func ApplyList(builder RequestBuilder, page PageOptions, filters ListFilters) RequestBuilder {
+	if page.PageNumber > 0 {
+		builder = builder.WithQueryParam("page[number]", strconv.Itoa(page.PageNumber))
+	}
+
+	if page.PageSize > 0 {
+		builder = builder.WithQueryParam("page[size]", strconv.Itoa(page.PageSize))
+	}
+
+	for param, values := range filters {
+		for _, value := range values {
+			if value != "" {
+				builder = builder.AddQueryParam(param, value)
+			}
+		}
+	}
+
+	return builder
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyList(t *testing.T) {
+	t.Run("Given paging parameters and filters should send them as query parameters", func(t *testing.T) {
+		// Arrange
+		page := PageOptions{PageNumber: 2, PageSize: 50}
+		filters := ListFilters{
+			"filter[status]":    {"pending", "confirmed"},
+			"filter[reference]": {"some_reference"},
+		}
+
+		expected := url.Values{
+			"page[number]":      {"2"},
+			"page[size]":        {"50"},
+			"filter[status]":    {"pending", "confirmed"},
+			"filter[reference]": {"some_reference"},
+		}
+
+		// Act
+		actual := ApplyList(NewRequestBuilder(http.MethodGet), page, filters).Build()
+
+		// Assert
+		assert.Equal(t, expected, actual.QueryParam)
+	})
+
+	t.Run("Given zero values should not send them", func(t *testing.T) {
+		// Arrange
+		filters := ListFilters{
+			"filter[status]":               nil,
+			"filter[processing_date_from]": {""},
+		}
+
+		// Act
+		actual := ApplyList(NewRequestBuilder(http.MethodGet), PageOptions{}, filters).Build()
+
+		// Assert
+		assert.Empty(t, actual.QueryParam)
+	})
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// RequestLogger writes structured records of the attempts sent by a BaseClient to a slog.Logger.
+//
+// Each attempt is logged when it starts and when it finishes, with its method, path and request id,
+// and once finished with its status code, duration and error. With LogBodies, the headers and bodies
+// are also logged at debug level, redacting the credentials headers and the fields tagged with redact.
+type RequestLogger struct {
+	Logger *slog.Logger
+	// StartLevel is the level of the records of the attempts being sent.
+	StartLevel slog.Level
+	// FinishLevel is the level of the records of the attempts that got a successful response.
+	FinishLevel slog.Level
+	// FailureLevel is the level of the records of the attempts that failed or got an unsuccessful response.
+	FailureLevel slog.Level
+	// LogBodies logs the headers and bodies of the requests and responses at debug level.
+	LogBodies bool
+	// RequestIDHeader is the header holding the request id, e.g. set by RequestIDMiddleware.
+	RequestIDHeader string
+	// RedactedHeaders are the headers whose values are never logged.
+	RedactedHeaders []string
+}
+
+// NewRequestLogger returns a RequestLogger writing to logger, slog.Default() when nil. Attempts are logged
+// at debug level when they start, at info level when they succeed and at warn level when they fail.
+func NewRequestLogger(logger *slog.Logger) *RequestLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &RequestLogger{
+		Logger:          logger,
+		StartLevel:      slog.LevelDebug,
+		FinishLevel:     slog.LevelInfo,
+		FailureLevel:    slog.LevelWarn,
+		RequestIDHeader: defaultRequestIDHeader,
+		RedactedHeaders: DefaultRedactedHeaders,
+	}
+}
+
+// started logs an attempt about to be sent.
+func (l *RequestLogger) started(apiReq *Request, httpReq *http.Request) {
+	if l == nil {
+		return
+	}
+
+	ctx := httpReq.Context()
+	l.Logger.LogAttrs(ctx, l.StartLevel, "api request started", l.requestAttrs(httpReq)...)
+
+	if l.logsBodies(ctx) {
+		l.Logger.LogAttrs(ctx, slog.LevelDebug, "api request body",
+			slog.Any("headers", RedactHeaders(httpReq.Header, l.RedactedHeaders)),
+			slog.Any("body", Redact(apiReq.Body)))
+	}
+}
+
+// finished logs the outcome of an attempt.
+func (l *RequestLogger) finished(apiReq *Request, httpReq *http.Request, apiResponse *Response, duration time.Duration, err error) {
+	if l == nil {
+		return
+	}
+
+	ctx := httpReq.Context()
+	statusCode := responseStatusCode(apiResponse, err)
+
+	attrs := append(l.requestAttrs(httpReq),
+		slog.Int("status", statusCode),
+		slog.Duration("duration", duration))
+
+	level, message := l.FinishLevel, "api request finished"
+	if err != nil || statusCode >= http.StatusBadRequest {
+		level, message = l.FailureLevel, "api request failed"
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	l.Logger.LogAttrs(ctx, level, message, attrs...)
+
+	if apiResponse != nil && l.logsBodies(ctx) {
+		l.Logger.LogAttrs(ctx, slog.LevelDebug, "api response body",
+			slog.Any("headers", RedactHeaders(apiResponse.RawResponse.Header, l.RedactedHeaders)),
+			l.responseBody(apiReq, apiResponse))
+	}
+}
+
+func (l *RequestLogger) requestAttrs(httpReq *http.Request) []slog.Attr {
+	attrs := []slog.Attr{
+		slog.String("method", httpReq.Method),
+		slog.String("path", httpReq.URL.Path),
+	}
+	if requestID := httpReq.Header.Get(l.RequestIDHeader); requestID != "" {
+		attrs = append(attrs, slog.String("request_id", requestID))
+	}
+	return attrs
+}
+
+func (l *RequestLogger) logsBodies(ctx context.Context) bool {
+	return l.LogBodies && l.Logger.Enabled(ctx, slog.LevelDebug)
+}
+
+// Returns the decoded body of the response, so that its tagged fields are redacted.
+// Bodies without a value to decode them into are only logged by size, since they cannot be redacted.
+func (l *RequestLogger) responseBody(apiReq *Request, apiResponse *Response) slog.Attr {
+	switch {
+	case apiResponse.IsSuccess() && apiReq.Result != nil:
+		return slog.Any("body", Redact(apiReq.Result))
+	case apiResponse.IsError() && apiReq.Error != nil:
+		return slog.Any("body", Redact(apiReq.Error))
+	}
+	return slog.Int64("body_bytes", apiResponse.Size())
+}
@@ -0,0 +1,212 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type loggedAccount struct {
+	Iban string `json:"iban" redact:"last4"`
+	Name string `json:"name" redact:"full"`
+}
+
+// Returns a RequestLogger writing JSON records down to the given level, along with a function parsing them.
+func newTestRequestLogger(level slog.Level) (*RequestLogger, func() []map[string]interface{}) {
+	var buffer bytes.Buffer
+	logger := NewRequestLogger(slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: level})))
+
+	return logger, func() []map[string]interface{} {
+		var records []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			record := map[string]interface{}{}
+			json.Unmarshal([]byte(line), &record)
+			records = append(records, record)
+		}
+		return records
+	}
+}
+
+func TestSendWithLogger(t *testing.T) {
+	url := url.URL{
+		Scheme: "http",
+		Host:   "example.com",
+	}
+
+	newHttpResponse := func(statusCode int, body string) *http.Response {
+		return &http.Response{StatusCode: statusCode, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewBufferString(body))}
+	}
+
+	t.Run("Given a successful request should log its start and finish", func(t *testing.T) {
+		// Arrange
+		logger, records := newTestRequestLogger(slog.LevelDebug)
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithPath("/v1/organisation/accounts").
+			WithHeader("X-Request-ID", "some_request_id").
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(200, ""), nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Logger:     logger,
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		actual := records()
+		assert.Len(t, actual, 2)
+		assert.Equal(t, "DEBUG", actual[0]["level"])
+		assert.Equal(t, "api request started", actual[0]["msg"])
+		assert.Equal(t, "GET", actual[0]["method"])
+		assert.Equal(t, "/v1/organisation/accounts", actual[0]["path"])
+		assert.Equal(t, "some_request_id", actual[0]["request_id"])
+		assert.Equal(t, "INFO", actual[1]["level"])
+		assert.Equal(t, "api request finished", actual[1]["msg"])
+		assert.Equal(t, float64(200), actual[1]["status"])
+		assert.Contains(t, actual[1], "duration")
+		assert.Equal(t, "some_request_id", actual[1]["request_id"])
+	})
+
+	t.Run("Given an unsuccessful status code should log the failure at the failure level", func(t *testing.T) {
+		// Arrange
+		logger, records := newTestRequestLogger(slog.LevelInfo)
+		logger.FailureLevel = slog.LevelError
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(404, ""), nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Logger:     logger,
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		actual := records()
+		assert.Len(t, actual, 1)
+		assert.Equal(t, "ERROR", actual[0]["level"])
+		assert.Equal(t, "api request failed", actual[0]["msg"])
+		assert.Equal(t, float64(404), actual[0]["status"])
+	})
+
+	t.Run("Given a transport error should log it", func(t *testing.T) {
+		// Arrange
+		logger, records := newTestRequestLogger(slog.LevelInfo)
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(nil, fmt.Errorf("connection reset"))
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Logger:     logger,
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.NotNil(t, err)
+		actual := records()
+		assert.Len(t, actual, 1)
+		assert.Equal(t, "WARN", actual[0]["level"])
+		assert.Contains(t, actual[0]["error"], "connection reset")
+	})
+
+	t.Run("Given body logging should log the headers and bodies redacted at debug level", func(t *testing.T) {
+		// Arrange
+		logger, records := newTestRequestLogger(slog.LevelDebug)
+		logger.LogBodies = true
+
+		result := &loggedAccount{}
+		apiReq := NewRequestBuilder(http.MethodPost).
+			WithHeader("Authorization", "Bearer some_token").
+			WithBody(&loggedAccount{Iban: "GB11NWBK40030041426819", Name: "Samantha Holder"}).
+			WithResultWriteTo(result).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(201, `{"iban":"GB11NWBK40030041426819","name":"Samantha Holder"}`), nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Logger:     logger,
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		actual := records()
+		assert.Len(t, actual, 4)
+		assert.Equal(t, "api request body", actual[1]["msg"])
+		assert.Equal(t, map[string]interface{}{"iban": "******************6819", "name": "[REDACTED]"}, actual[1]["body"])
+		assert.Equal(t, []interface{}{"[REDACTED]"}, actual[1]["headers"].(map[string]interface{})["Authorization"])
+		assert.Equal(t, "api response body", actual[3]["msg"])
+		assert.Equal(t, map[string]interface{}{"iban": "******************6819", "name": "[REDACTED]"}, actual[3]["body"])
+		assert.Equal(t, "Samantha Holder", result.Name)
+	})
+
+	t.Run("Given body logging above debug level should not log the bodies", func(t *testing.T) {
+		// Arrange
+		logger, records := newTestRequestLogger(slog.LevelInfo)
+		logger.LogBodies = true
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(200, `{}`), nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Logger:     logger,
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		actual := records()
+		assert.Len(t, actual, 1)
+		assert.Equal(t, "api request finished", actual[0]["msg"])
+	})
+}
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httputil"
+
+	"github.com/google/uuid"
+)
+
+const(
+	defaultRequestIDHeader string = "X-Request-ID"
+)
+
+// Handler sends the built http request of a Request and returns the resulting Response or error.
+type Handler func(apiReq *Request, httpReq *http.Request) (*Response, error)
+
+// Middleware wraps a Handler to run code before and after the http request is sent.
+// A middleware can short-circuit the chain by returning without calling next.
+// Middlewares run once per attempt, in the order they were registered in BaseClient.Middlewares.
+type Middleware func(next Handler) Handler
+
+// RequestIDMiddleware sets a unique request id in the given header of every attempt that does not have one yet.
+// An empty header defaults to X-Request-ID and a nil generate defaults to random UUIDs.
+func RequestIDMiddleware(header string, generate func() string) Middleware {
+	if header == "" {
+		header = defaultRequestIDHeader
+	}
+	if generate == nil {
+		generate = uuid.NewString
+	}
+
+	return func(next Handler) Handler {
+		return func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+			if httpReq.Header.Get(header) == "" {
+				httpReq.Header.Set(header, generate())
+			}
+			return next(apiReq, httpReq)
+		}
+	}
+}
+
+// StaticHeadersMiddleware sets the given headers in every http request, replacing existing values.
+func StaticHeadersMiddleware(headers http.Header) Middleware {
+	return func(next Handler) Handler {
+		return func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+			for key, values := range headers {
+				httpReq.Header.Del(key)
+				for _, value := range values {
+					httpReq.Header.Add(key, value)
+				}
+			}
+			return next(apiReq, httpReq)
+		}
+	}
+}
+
+// DumpMiddleware writes the full http request and response, bodies included, to the logger.
+// It is meant for debugging, since the dumps can contain sensitive data.
+//
+// The response body is the one retained by the client, see Response.Body: bodies above 64KB are only
+// dumped with BaseClient.RetainBody set, and are otherwise replaced by a note giving their size.
+func DumpMiddleware(logger *log.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+			if dump, err := httputil.DumpRequestOut(httpReq, true); err == nil {
+				logger.Printf("Request:\n%s", dump)
+			}
+
+			apiResponse, err := next(apiReq, httpReq)
+
+			if err != nil {
+				logger.Printf("Error: %v", err)
+				return apiResponse, err
+			}
+
+			// a short-circuiting middleware may return a response without http response
+			if apiResponse == nil || apiResponse.RawResponse == nil {
+				logger.Printf("Response: none")
+				return apiResponse, err
+			}
+
+			if dump, dumpErr := httputil.DumpResponse(apiResponse.RawResponse, false); dumpErr == nil {
+				body := apiResponse.Body()
+				if len(body) == 0 && apiResponse.Size() > 0 {
+					body = []byte(fmt.Sprintf("[body of %d bytes not retained, see BaseClient.RetainBody]", apiResponse.Size()))
+				}
+				logger.Printf("Response:\n%s%s", dump, body)
+			}
+
+			return apiResponse, err
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	url := url.URL{
+		Scheme: "http",
+		Host:   "example.com",
+	}
+
+	recordingMiddleware := func(name string, calls *[]string) Middleware {
+		return func(next Handler) Handler {
+			return func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+				*calls = append(*calls, "before "+name)
+				apiResponse, err := next(apiReq, httpReq)
+				*calls = append(*calls, "after "+name)
+				return apiResponse, err
+			}
+		}
+	}
+
+	t.Run("Given multiple middlewares should run them in the order they were registered", func(t *testing.T) {
+		// Arrange
+		var calls []string
+
+		httpResponse := &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(httpResponse, nil).Run(func(args mock.Arguments) {
+			calls = append(calls, "do")
+		})
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			Middlewares: []Middleware{recordingMiddleware("first", &calls), recordingMiddleware("second", &calls)},
+		}
+
+		// Act
+		_, err := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"before first", "before second", "do", "after second", "after first"}, calls)
+	})
+
+	t.Run("Given a middleware that short-circuits should not call the http client", func(t *testing.T) {
+		// Arrange
+		expected := &Response{}
+
+		shortCircuit := func(next Handler) Handler {
+			return func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+				return expected, nil
+			}
+		}
+
+		mockedHttpClient := new(MockedHttpClient)
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			Middlewares: []Middleware{shortCircuit},
+		}
+
+		// Act
+		actual, err := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Same(t, expected, actual)
+		mockedHttpClient.AssertNotCalled(t, "Do", mock.Anything)
+	})
+
+	t.Run("Given a middleware returning neither a response nor an error should return an error", func(t *testing.T) {
+		// Arrange
+		empty := func(next Handler) Handler {
+			return func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+				return nil, nil
+			}
+		}
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: new(MockedHttpClient),
+			Timeout: 100,
+			RetryPolicy: &RetryPolicy{MaxAttempts: 3},
+			Middlewares: []Middleware{empty},
+		}
+
+		// Act
+		actual, err := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Given a middleware returning an error should not retry the request", func(t *testing.T) {
+		// Arrange
+		var calls []string
+		expectedError := fmt.Errorf("Some error occurred")
+
+		failing := func(next Handler) Handler {
+			return func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+				return nil, expectedError
+			}
+		}
+
+		sut := &BaseClient{
+			BaseUrl: url,
+			HttpClient: new(MockedHttpClient),
+			Timeout: 100,
+			RetryPolicy: &RetryPolicy{MaxAttempts: 3},
+			Middlewares: []Middleware{recordingMiddleware("counter", &calls), failing},
+		}
+
+		// Act
+		actual, err := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, []string{"before counter", "after counter"}, calls)
+	})
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	next := func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+		return &Response{}, nil
+	}
+
+	t.Run("Given a request without request id should set a generated one", func(t *testing.T) {
+		// Arrange
+		httpReq, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+		sut := RequestIDMiddleware("", func() string { return "some_id" })
+
+		// Act
+		_, err := sut(next)(&Request{}, httpReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_id", httpReq.Header.Get("X-Request-ID"))
+	})
+
+	t.Run("Given a request with request id should keep it", func(t *testing.T) {
+		// Arrange
+		httpReq, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		httpReq.Header.Set("X-Correlation-ID", "existing_id")
+
+		sut := RequestIDMiddleware("X-Correlation-ID", nil)
+
+		// Act
+		_, err := sut(next)(&Request{}, httpReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "existing_id", httpReq.Header.Get("X-Correlation-ID"))
+	})
+}
+
+func TestStaticHeadersMiddleware(t *testing.T) {
+	t.Run("Given static headers should replace them in the request", func(t *testing.T) {
+		// Arrange
+		httpReq, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		httpReq.Header.Set("Accept", "text/plain")
+
+		headers := http.Header{}
+		headers.Set("Accept", "application/vnd.api+json")
+		headers.Set("X-Tenant", "some_tenant")
+
+		next := func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+			return &Response{}, nil
+		}
+
+		sut := StaticHeadersMiddleware(headers)
+
+		// Act
+		_, err := sut(next)(&Request{}, httpReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"application/vnd.api+json"}, httpReq.Header.Values("Accept"))
+		assert.Equal(t, "some_tenant", httpReq.Header.Get("X-Tenant"))
+	})
+}
+
+func TestDumpMiddleware(t *testing.T) {
+	t.Run("Given a request and response should write both to the logger", func(t *testing.T) {
+		// Arrange
+		var output bytes.Buffer
+		logger := log.New(&output, "", 0)
+
+		httpReq, _ := http.NewRequest(http.MethodPost, "http://example.com/some_path", bytes.NewBufferString(`{"request":true}`))
+
+		next := func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+			return &Response{
+				RawResponse: &http.Response{StatusCode: 200, ProtoMajor: 1, ProtoMinor: 1, Header: http.Header{}, Body: http.NoBody},
+				body: []byte(`{"response":true}`),
+			}, nil
+		}
+
+		sut := DumpMiddleware(logger)
+
+		// Act
+		_, err := sut(next)(&Request{}, httpReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, output.String(), "POST /some_path")
+		assert.Contains(t, output.String(), `{"request":true}`)
+		assert.Contains(t, output.String(), "200")
+		assert.Contains(t, output.String(), `{"response":true}`)
+	})
+
+	t.Run("Given a response whose body was not retained should write its size instead", func(t *testing.T) {
+		// Arrange
+		var output bytes.Buffer
+		logger := log.New(&output, "", 0)
+
+		httpReq, _ := http.NewRequest(http.MethodGet, "http://example.com/some_path", nil)
+
+		next := func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+			return &Response{
+				RawResponse: &http.Response{StatusCode: 200, ProtoMajor: 1, ProtoMinor: 1, Header: http.Header{}, Body: http.NoBody},
+				size: 100 << 10,
+			}, nil
+		}
+
+		sut := DumpMiddleware(logger)
+
+		// Act
+		_, err := sut(next)(&Request{}, httpReq)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, output.String(), "[body of 102400 bytes not retained, see BaseClient.RetainBody]")
+	})
+}
+
+func TestDumpMiddlewareWithShortCircuit(t *testing.T) {
+	t.Run("Given a short-circuiting middleware returning a response without http response should not panic", func(t *testing.T) {
+		// Arrange
+		var output bytes.Buffer
+		logger := log.New(&output, "", 0)
+		expected := &Response{}
+
+		shortCircuit := func(next Handler) Handler {
+			return func(apiReq *Request, httpReq *http.Request) (*Response, error) {
+				return expected, nil
+			}
+		}
+
+		mockedHttpClient := new(MockedHttpClient)
+
+		sut := &BaseClient{
+			BaseUrl: url.URL{Scheme: "http", Host: "example.com"},
+			HttpClient: mockedHttpClient,
+			Timeout: 100,
+			Middlewares: []Middleware{DumpMiddleware(logger), shortCircuit},
+		}
+
+		// Act
+		actual, err := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Same(t, expected, actual)
+		assert.Contains(t, output.String(), "Response: none")
+		mockedHttpClient.AssertNotCalled(t, "Do", mock.Anything)
+	})
+}
@@ -0,0 +1,182 @@
+package core
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// the rate of an adaptive limiter is never halved below its configured rate divided by this factor
+	minRateDivisor float64 = 64
+	// the rate of an adaptive limiter grows back by its configured rate divided by this factor on every response that does not slow it down
+	rateIncreaseDivisor float64 = 16
+	// X-RateLimit-Reset values above this are unix timestamps rather than seconds to wait
+	epochThreshold int64 = 1e9
+)
+
+// RateLimiter is a token bucket limiting the rate of the requests sent by a BaseClient.
+// Each attempt of a request takes a token, waiting for one when the bucket is empty.
+// It is safe for concurrent use, so that a single limiter is shared by all the resource clients.
+//
+// An adaptive limiter also follows the rate limit headers of the responses: it pauses until the time given
+// by Retry-After or until X-RateLimit-Reset when X-RateLimit-Remaining is 0, slows down to spread the remaining
+// requests until the reset, and halves its rate on 429 responses without such headers. A halved rate grows back
+// towards the configured rate by a sixteenth of it on every response that is neither a 429 nor carries such headers.
+type RateLimiter struct {
+	mu          sync.Mutex
+	limit       float64
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	adaptive    bool
+	now         func() time.Time
+}
+
+// NewRateLimiter returns a limiter allowing rps requests per second on average, with bursts of up to burst requests.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		limit:  rps,
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// NewAdaptiveRateLimiter returns a limiter like NewRateLimiter that also slows down following the rate limit
+// headers of the responses, never going above rps.
+func NewAdaptiveRateLimiter(rps float64, burst int) *RateLimiter {
+	limiter := NewRateLimiter(rps, burst)
+	limiter.adaptive = true
+	return limiter
+}
+
+// Wait blocks until a request can be sent or ctx is done, in which case it returns the context error.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancelReservation()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Takes a token and returns how long to wait for it.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	var wait time.Duration
+	if l.pausedUntil.After(now) {
+		wait = l.pausedUntil.Sub(now)
+	}
+
+	l.tokens--
+	if l.tokens < 0 {
+		if tokenWait := time.Duration(-l.tokens / l.rate * float64(time.Second)); tokenWait > wait {
+			wait = tokenWait
+		}
+	}
+
+	return wait
+}
+
+func (l *RateLimiter) cancelReservation() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+}
+
+// Observe adapts the rate of an adaptive limiter to the rate limit headers of the response.
+// It does nothing for a limiter that is not adaptive.
+func (l *RateLimiter) Observe(resp *http.Response) {
+	if l == nil || !l.adaptive || resp == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+		l.pause(now.Add(wait))
+	}
+
+	remaining, hasRemaining := parseHeaderInt(resp.Header.Get("X-RateLimit-Remaining"))
+	reset, hasReset := parseRateLimitReset(resp.Header.Get("X-RateLimit-Reset"), now)
+
+	switch {
+	case hasRemaining && hasReset && remaining <= 0:
+		l.pause(reset)
+	case hasRemaining && hasReset && reset.After(now):
+		// spread the remaining requests until the reset
+		l.rate = math.Min(l.limit, float64(remaining)/reset.Sub(now).Seconds())
+	case hasRemaining && hasReset:
+		l.rate = l.limit
+	case resp.StatusCode == http.StatusTooManyRequests:
+		l.rate = math.Max(l.rate/2, l.limit/minRateDivisor)
+	default:
+		l.rate = math.Min(l.limit, l.rate+l.limit/rateIncreaseDivisor)
+	}
+}
+
+func (l *RateLimiter) pause(until time.Time) {
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+	// the requests waiting for the end of the pause should not all be sent at once
+	l.tokens = math.Min(l.tokens, 0)
+}
+
+func parseHeaderInt(value string) (int64, bool) {
+	if value == "" {
+		return 0, false
+	}
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	return parsed, err == nil
+}
+
+// Parses the X-RateLimit-Reset header, either a number of seconds until the reset or the unix time of the reset.
+func parseRateLimitReset(value string, now time.Time) (time.Time, bool) {
+	seconds, ok := parseHeaderInt(value)
+	if !ok || seconds < 0 {
+		return time.Time{}, false
+	}
+
+	if seconds > epochThreshold {
+		return time.Unix(seconds, 0), true
+	}
+	return now.Add(time.Duration(seconds) * time.Second), true
+}
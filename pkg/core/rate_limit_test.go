@@ -0,0 +1,208 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Returns a limiter whose clock only moves when the returned function is called.
+func newTestRateLimiter(limiter *RateLimiter) (*RateLimiter, func(time.Duration)) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	return limiter, func(d time.Duration) { now = now.Add(d) }
+}
+
+func responseWithHeaders(statusCode int, headers map[string]string) *http.Response {
+	resp := &http.Response{StatusCode: statusCode, Header: http.Header{}}
+	for key, value := range headers {
+		resp.Header.Set(key, value)
+	}
+	return resp
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Run("Given a burst should let it through and then space the requests by the rate", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestRateLimiter(NewRateLimiter(10, 2))
+
+		// Act
+		first, second, third, fourth := sut.reserve(), sut.reserve(), sut.reserve(), sut.reserve()
+
+		// Assert
+		assert.Equal(t, time.Duration(0), first)
+		assert.Equal(t, time.Duration(0), second)
+		assert.Equal(t, 100*time.Millisecond, third)
+		assert.Equal(t, 200*time.Millisecond, fourth)
+	})
+
+	t.Run("Given time passing should refill the bucket up to the burst", func(t *testing.T) {
+		// Arrange
+		sut, advance := newTestRateLimiter(NewRateLimiter(10, 2))
+		sut.reserve()
+		sut.reserve()
+
+		// Act
+		advance(time.Hour)
+
+		// Assert
+		assert.Equal(t, time.Duration(0), sut.reserve())
+		assert.Equal(t, time.Duration(0), sut.reserve())
+		assert.Equal(t, 100*time.Millisecond, sut.reserve())
+	})
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	t.Run("Given a context done before a token is available should return the context error and give the token back", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestRateLimiter(NewRateLimiter(1, 1))
+		sut.reserve()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		// Act
+		err := sut.Wait(ctx)
+
+		// Assert
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, float64(0), sut.tokens)
+	})
+
+	t.Run("Given a nil limiter should not wait", func(t *testing.T) {
+		// Arrange
+		var sut *RateLimiter
+
+		// Act
+		err := sut.Wait(context.Background())
+
+		// Assert
+		assert.Nil(t, err)
+	})
+}
+
+func TestRateLimiterObserve(t *testing.T) {
+	t.Run("Given a Retry-After header should pause until then", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestRateLimiter(NewAdaptiveRateLimiter(10, 5))
+
+		// Act
+		sut.Observe(responseWithHeaders(http.StatusTooManyRequests, map[string]string{"Retry-After": "2"}))
+
+		// Assert
+		assert.Equal(t, 2*time.Second, sut.reserve())
+	})
+
+	t.Run("Given no remaining requests should pause until the reset", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestRateLimiter(NewAdaptiveRateLimiter(10, 5))
+		reset := strconv.FormatInt(sut.now().Add(3*time.Second).Unix(), 10)
+
+		// Act
+		sut.Observe(responseWithHeaders(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": reset}))
+
+		// Assert
+		assert.Equal(t, 3*time.Second, sut.reserve())
+	})
+
+	t.Run("Given few remaining requests should spread them until the reset", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestRateLimiter(NewAdaptiveRateLimiter(10, 5))
+
+		// Act
+		sut.Observe(responseWithHeaders(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "5", "X-RateLimit-Reset": "10"}))
+
+		// Assert
+		assert.Equal(t, 0.5, sut.rate)
+	})
+
+	t.Run("Given many remaining requests should not go above the configured rate", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestRateLimiter(NewAdaptiveRateLimiter(10, 5))
+		sut.rate = 1
+
+		// Act
+		sut.Observe(responseWithHeaders(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "1000", "X-RateLimit-Reset": "10"}))
+
+		// Assert
+		assert.Equal(t, float64(10), sut.rate)
+	})
+
+	t.Run("Given a 429 without rate limit headers should halve the rate", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestRateLimiter(NewAdaptiveRateLimiter(10, 5))
+
+		// Act
+		sut.Observe(responseWithHeaders(http.StatusTooManyRequests, nil))
+		sut.Observe(responseWithHeaders(http.StatusTooManyRequests, nil))
+
+		// Assert
+		assert.Equal(t, 2.5, sut.rate)
+	})
+
+	t.Run("Given responses other than 429 after a 429 should grow the rate back to the configured rate", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestRateLimiter(NewAdaptiveRateLimiter(16, 5))
+		sut.Observe(responseWithHeaders(http.StatusTooManyRequests, nil))
+
+		// Act
+		sut.Observe(responseWithHeaders(http.StatusOK, nil))
+		sut.Observe(responseWithHeaders(http.StatusServiceUnavailable, nil))
+		rateAfterTwo := sut.rate
+
+		for i := 0; i < 10; i++ {
+			sut.Observe(responseWithHeaders(http.StatusOK, nil))
+		}
+
+		// Assert
+		assert.Equal(t, float64(10), rateAfterTwo)
+		assert.Equal(t, float64(16), sut.rate)
+	})
+
+	t.Run("Given a limiter that is not adaptive should ignore the headers", func(t *testing.T) {
+		// Arrange
+		sut, _ := newTestRateLimiter(NewRateLimiter(10, 5))
+
+		// Act
+		sut.Observe(responseWithHeaders(http.StatusTooManyRequests, map[string]string{"Retry-After": "2"}))
+
+		// Assert
+		assert.Equal(t, time.Duration(0), sut.reserve())
+		assert.Equal(t, float64(10), sut.rate)
+	})
+}
+
+func TestSendWithRateLimiter(t *testing.T) {
+	t.Run("Given no token available before the request context is done should not send the request", func(t *testing.T) {
+		// Arrange
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}, nil)
+
+		sut := &BaseClient{
+			BaseUrl:     url.URL{Scheme: "http", Host: "example.com"},
+			HttpClient:  mockedHttpClient,
+			RateLimiter: NewRateLimiter(1, 1),
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, firstErr := sut.Send(NewRequestBuilder(http.MethodGet).WithContext(ctx).Build())
+		_, secondErr := sut.Send(NewRequestBuilder(http.MethodGet).WithContext(ctx).Build())
+
+		// Assert
+		assert.Nil(t, firstErr)
+		assert.True(t, errors.Is(secondErr, context.DeadlineExceeded))
+		mockedHttpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+}
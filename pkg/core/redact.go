@@ -0,0 +1,159 @@
+package core
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// Redaction policies of the redact struct tag, which marks the fields of a model holding sensitive data:
+//
+//	Iban string `json:"iban,omitempty" redact:"last4"`
+//	Name []string `json:"name,omitempty" redact:"full"`
+//
+// The policies apply to string fields, and to pointers to and slices of strings. Any other tagged field is zeroed.
+const (
+	RedactTag = "redact"
+	// RedactFull replaces the whole value.
+	RedactFull = "full"
+	// RedactLast4 keeps the last 4 characters of the value, e.g. to tell accounts apart.
+	RedactLast4 = "last4"
+
+	redactedValue = "[REDACTED]"
+)
+
+// DefaultRedactedHeaders are the headers holding credentials, never logged as is.
+var DefaultRedactedHeaders = []string{"Authorization", "Proxy-Authorization", "Signature"}
+
+// Redact returns a deep copy of v where the fields tagged with redact are masked according to their policy.
+// Values without tagged fields are returned as they are.
+func Redact(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	return redactValue(reflect.ValueOf(v)).Interface()
+}
+
+func redactValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Elem().Type())
+		copied.Elem().Set(redactValue(v.Elem()))
+		return copied
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(redactValue(v.Elem()))
+		return copied
+
+	case reflect.Struct:
+		// copies every field, the unexported ones included, before replacing the exported ones
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			if policy, ok := field.Tag.Lookup(RedactTag); ok {
+				copied.Field(i).Set(mask(v.Field(i), policy))
+			} else {
+				copied.Field(i).Set(redactValue(v.Field(i)))
+			}
+		}
+		return copied
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(redactValue(v.Index(i)))
+		}
+		return copied
+
+	case reflect.Array:
+		copied := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(redactValue(v.Index(i)))
+		}
+		return copied
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), redactValue(iter.Value()))
+		}
+		return copied
+	}
+
+	return v
+}
+
+// Masks the value of a tagged field according to policy.
+func mask(v reflect.Value, policy string) reflect.Value {
+	switch v.Kind() {
+	case reflect.String:
+		masked := reflect.New(v.Type()).Elem()
+		masked.SetString(maskString(v.String(), policy))
+		return masked
+
+	case reflect.Ptr, reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		if v.Kind() == reflect.Ptr {
+			masked := reflect.New(v.Elem().Type())
+			masked.Elem().Set(mask(v.Elem(), policy))
+			return masked
+		}
+		masked := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			masked.Index(i).Set(mask(v.Index(i), policy))
+		}
+		return masked
+	}
+
+	return reflect.Zero(v.Type())
+}
+
+func maskString(value string, policy string) string {
+	if value == "" {
+		return ""
+	}
+
+	if policy == RedactLast4 {
+		runes := []rune(value)
+		if len(runes) > 4 {
+			return strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-4:])
+		}
+	}
+
+	return redactedValue
+}
+
+// RedactHeaders returns a copy of header where the values of the given headers are replaced.
+func RedactHeaders(header http.Header, names []string) http.Header {
+	redacted := header.Clone()
+	for _, name := range names {
+		if values := redacted.Values(name); len(values) > 0 {
+			masked := make([]string, len(values))
+			for i := range masked {
+				masked[i] = redactedValue
+			}
+			redacted[http.CanonicalHeaderKey(name)] = masked
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,115 @@
+package core
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type redactedHolder struct {
+	Name     string            `json:"name" redact:"full"`
+	Number   string            `json:"number" redact:"last4"`
+	Aliases  *[]string         `json:"aliases" redact:"full"`
+	Balance  int               `json:"balance" redact:"full"`
+	Currency string            `json:"currency"`
+	Labels   map[string]string `json:"labels"`
+	secret   string
+}
+
+type redactedEnvelope struct {
+	Data  *redactedHolder  `json:"data"`
+	Items []redactedHolder `json:"items"`
+	Any   interface{}      `json:"any"`
+}
+
+func TestRedact(t *testing.T) {
+	t.Run("Given tagged fields should mask them according to their policy", func(t *testing.T) {
+		// Arrange
+		aliases := []string{"Sam", "S. Holder"}
+		holder := &redactedHolder{Name: "Samantha Holder", Number: "41426819", Aliases: &aliases, Balance: 100, Currency: "GBP", secret: "kept"}
+
+		// Act
+		actual := Redact(holder).(*redactedHolder)
+
+		// Assert
+		assert.Equal(t, "[REDACTED]", actual.Name)
+		assert.Equal(t, "****6819", actual.Number)
+		assert.Equal(t, []string{"[REDACTED]", "[REDACTED]"}, *actual.Aliases)
+		assert.Equal(t, 0, actual.Balance)
+		assert.Equal(t, "GBP", actual.Currency)
+		assert.Equal(t, "kept", actual.secret)
+	})
+
+	t.Run("Given tagged fields nested in pointers, slices and interfaces should mask them", func(t *testing.T) {
+		// Arrange
+		envelope := redactedEnvelope{
+			Data:  &redactedHolder{Name: "Samantha Holder"},
+			Items: []redactedHolder{{Number: "41426819"}},
+			Any:   &redactedHolder{Name: "Samantha Holder"},
+		}
+
+		// Act
+		actual := Redact(envelope).(redactedEnvelope)
+
+		// Assert
+		assert.Equal(t, "[REDACTED]", actual.Data.Name)
+		assert.Equal(t, "****6819", actual.Items[0].Number)
+		assert.Equal(t, "[REDACTED]", actual.Any.(*redactedHolder).Name)
+	})
+
+	t.Run("Given a value should not modify it", func(t *testing.T) {
+		// Arrange
+		holder := &redactedHolder{Name: "Samantha Holder", Labels: map[string]string{"tier": "gold"}}
+
+		// Act
+		actual := Redact(holder).(*redactedHolder)
+		actual.Labels["tier"] = "silver"
+
+		// Assert
+		assert.Equal(t, "Samantha Holder", holder.Name)
+		assert.Equal(t, "gold", holder.Labels["tier"])
+	})
+
+	t.Run("Given values shorter than 5 characters should redact them fully", func(t *testing.T) {
+		// Act
+		actual := Redact(redactedHolder{Number: "1234"}).(redactedHolder)
+
+		// Assert
+		assert.Equal(t, "[REDACTED]", actual.Number)
+	})
+
+	t.Run("Given empty values should leave them empty", func(t *testing.T) {
+		// Act
+		actual := Redact(redactedHolder{}).(redactedHolder)
+
+		// Assert
+		assert.Empty(t, actual.Name)
+		assert.Nil(t, actual.Aliases)
+	})
+
+	t.Run("Given nil should return nil", func(t *testing.T) {
+		// Act & Assert
+		assert.Nil(t, Redact(nil))
+	})
+}
+
+func TestRedactHeaders(t *testing.T) {
+	t.Run("Given credentials headers should replace their values and leave the others", func(t *testing.T) {
+		// Arrange
+		header := http.Header{}
+		header.Set("Authorization", "Bearer some_token")
+		header.Set("Signature", `keyId="some_key_id"`)
+		header.Set("X-Request-ID", "some_request_id")
+
+		// Act
+		actual := RedactHeaders(header, DefaultRedactedHeaders)
+
+		// Assert
+		assert.Equal(t, "[REDACTED]", actual.Get("Authorization"))
+		assert.Equal(t, "[REDACTED]", actual.Get("Signature"))
+		assert.Equal(t, "some_request_id", actual.Get("X-Request-ID"))
+		assert.Equal(t, "Bearer some_token", header.Get("Authorization"))
+		assert.Empty(t, actual.Values("Proxy-Authorization"))
+	})
+}
@@ -6,16 +6,47 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+
+	"github.com/google/uuid"
+)
+
+const(
+	IdempotencyKeyHeader string = "Idempotency-Key"
 )
 
+type idempotencyKeyContextKey struct{}
+
+// NewIdempotencyKey returns a new random idempotency key.
+func NewIdempotencyKey() string {
+	return uuid.NewString()
+}
+
+// ContextWithIdempotencyKey returns a copy of the context carrying the idempotency key of a logical operation,
+// so that callers retrying the operation themselves, e.g. after a timeout, send it with the same key.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key set with ContextWithIdempotencyKey, if any.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key, ok && key != ""
+}
+
 type Request struct {
 	Method          string
 	Path            string
 	QueryParam 		url.Values
+	Headers 		http.Header
 	Body            interface{}
 	Result			interface{}
 	Error  			interface{}
 	Context         context.Context
+	// Timeouts replaces the budgets it sets in the timeouts of the client for this request.
+	Timeouts        *Timeouts
 }
 
 func (r *Request) buildHttpRequest(baseUrl url.URL) (*http.Request, error){
@@ -45,9 +76,26 @@ func (r *Request) buildHttpRequest(baseUrl url.URL) (*http.Request, error){
     }
 	request.Header.Set("Accept", "application/json")
 
+	for key, values := range r.Headers {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+
 	return request, nil
 }
 
+// isIdempotent reports whether the request can be safely sent more than once,
+// either because of its http method or because it carries an idempotency key.
+func (r *Request) isIdempotent() bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return r.Headers.Get(IdempotencyKeyHeader) != ""
+}
+
 func (r *Request) getContext() context.Context {
 	if r.Context == nil {
 		return context.Background()
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 	"strings"
 )
@@ -11,9 +12,13 @@ type RequestBuilder interface {
 	WithPath(value string ) RequestBuilder
 	WithBody(body interface{}) RequestBuilder
 	WithQueryParam(key, value string) RequestBuilder
+	AddQueryParam(key, value string) RequestBuilder
+	WithHeader(key, value string) RequestBuilder
+	WithIdempotencyKey(key string) RequestBuilder
 	WithResultWriteTo(value interface{}) RequestBuilder
 	WithErrorWriteTo(value interface{}) RequestBuilder
 	WithContext(context context.Context) RequestBuilder
+	WithTimeouts(timeouts Timeouts) RequestBuilder
 	Build() (*Request)
 }
 
@@ -21,16 +26,19 @@ type requestBuilderImpl struct {
 	httpMethod      	string
 	path 				[]string
 	queryParam 			url.Values
+	headers 			http.Header
 	body            	interface{}
 	resultWriter     	interface{}
 	errorWriter      	interface{}
 	context 			context.Context
+	timeouts 			*Timeouts
 }
 
 func NewRequestBuilder(method string) *requestBuilderImpl {
 	return &requestBuilderImpl{
 		httpMethod: method,
 		queryParam: url.Values{},
+		headers: http.Header{},
 	}
 }
 
@@ -49,6 +57,24 @@ func (r requestBuilderImpl) WithQueryParam(param, value string) RequestBuilder{
 	return &r
 }
 
+// AddQueryParam appends a value to the query parameter, keeping any values already set for the same key.
+func (r requestBuilderImpl) AddQueryParam(param, value string) RequestBuilder{
+	r.queryParam.Add(param, value)
+	return &r
+}
+
+func (r requestBuilderImpl) WithHeader(key, value string) RequestBuilder{
+	r.headers.Set(key, value)
+	return &r
+}
+
+// WithIdempotencyKey sets the Idempotency-Key header, which makes the request safe to retry.
+// The same key must be used for every attempt of the same logical operation.
+func (r requestBuilderImpl) WithIdempotencyKey(key string) RequestBuilder{
+	r.headers.Set(IdempotencyKeyHeader, key)
+	return &r
+}
+
 func (r requestBuilderImpl) WithContext(value context.Context) RequestBuilder{
 	r.context = value
 	return &r
@@ -64,6 +90,12 @@ func (r requestBuilderImpl) WithErrorWriteTo(value interface {}) RequestBuilder{
 	return &r
 }
 
+// WithTimeouts overrides the timeouts of the client and of the resource with the budgets set in timeouts.
+func (r requestBuilderImpl) WithTimeouts(timeouts Timeouts) RequestBuilder{
+	r.timeouts = &timeouts
+	return &r
+}
+
 func (r requestBuilderImpl) Build() (*Request){
 	finalPath := strings.Join(r.path, "/")
 
@@ -71,9 +103,11 @@ func (r requestBuilderImpl) Build() (*Request){
 		Method: r.httpMethod,
 		Path: finalPath,
 		QueryParam: r.queryParam,
+		Headers: r.headers,
 		Body: r.body,
 		Result: r.resultWriter,
 		Error: r.errorWriter,
 		Context: r.context,
+		Timeouts: r.timeouts,
 	}
 }
\ No newline at end of file
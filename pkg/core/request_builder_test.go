@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -17,6 +18,7 @@ func TestBuild(t *testing.T) {
 			Method: http.MethodGet,
 			Path: "",
 			QueryParam: url.Values{},
+			Headers: http.Header{},
 			Body: nil,
 			Result: nil,
 			Error: nil,
@@ -58,6 +60,46 @@ func TestBuild(t *testing.T) {
 		assert.Equal(t, expected, actual.QueryParam)
 	})
 
+	t.Run("Given multiple values for the same query parameter should return a request with all the values", func(t *testing.T) {
+		// Arrange
+		expected := make(url.Values)
+		expected.Add("some_parameter","some_value_1")
+		expected.Add("some_parameter","some_value_2")
+
+		// Act
+		actual := NewRequestBuilder(http.MethodGet).
+			AddQueryParam("some_parameter", "some_value_1").
+			AddQueryParam("some_parameter", "some_value_2").
+			Build()
+
+		// Assert
+		assert.Equal(t, expected, actual.QueryParam)
+	})
+
+	t.Run("Given headers should return a request with respective headers", func(t *testing.T) {
+		// Arrange
+		expected := http.Header{}
+		expected.Set("X-Some-Header","some_value")
+
+		// Act
+		actual := NewRequestBuilder(http.MethodGet).
+			WithHeader("X-Some-Header", "some_value").
+			Build()
+
+		// Assert
+		assert.Equal(t, expected, actual.Headers)
+	})
+
+	t.Run("Given an idempotency key should return a request with the Idempotency-Key header", func(t *testing.T) {
+		// Act
+		actual := NewRequestBuilder(http.MethodPost).
+			WithIdempotencyKey("some_key").
+			Build()
+
+		// Assert
+		assert.Equal(t, "some_key", actual.Headers.Get(IdempotencyKeyHeader))
+	})
+
 	t.Run("Given a body should return a request with respective body", func(t *testing.T) {
 		// Arrange
 		type SampleBody struct{}
@@ -113,4 +155,17 @@ func TestBuild(t *testing.T) {
 		assert.Equal(t, expected, actual.Error)
 	})
 
-}
\ No newline at end of file
+	t.Run("Given timeouts should return a request with respective timeouts", func(t *testing.T) {
+		// Arrange
+		expected := Timeouts{PerAttempt: time.Second}
+
+		// Act
+		actual := NewRequestBuilder(http.MethodGet).
+			WithTimeouts(expected).
+			Build()
+
+		// Assert
+		assert.Equal(t, &expected, actual.Timeouts)
+	})
+
+}
@@ -131,3 +131,30 @@ func TestGetContext(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 }
+
+func TestIdempotencyKeyFromContext(t *testing.T) {
+	t.Run("Given a context with an idempotency key should return it", func(t *testing.T) {
+		// Arrange
+		ctx := ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		actual, ok := IdempotencyKeyFromContext(ctx)
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, "some_key", actual)
+	})
+
+	t.Run("Given a context without idempotency key should not return a key", func(t *testing.T) {
+		// Act
+		_, ok := IdempotencyKeyFromContext(context.Background())
+
+		// Assert
+		assert.False(t, ok)
+	})
+
+	t.Run("Given new idempotency keys should return different keys", func(t *testing.T) {
+		// Act & Assert
+		assert.NotEqual(t, NewIdempotencyKey(), NewIdempotencyKey())
+	})
+}
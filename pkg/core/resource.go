@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// apiError is the body of the unsuccessful responses of the API.
+type apiError struct {
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// identity holds the identity of a resource, as found in the data of its requests and responses.
+type identity struct {
+	Data *struct {
+		ID             string `json:"id"`
+		OrganisationID string `json:"organisation_id"`
+	} `json:"data"`
+}
+
+// SendExpecting sends the request built so far with ctx and decodes its response into result, provided it has
+// the expected status code. Any other response is turned into the respective API error, see NewErrorFromResponse.
+func SendExpecting(ctx context.Context, client Client, builder RequestBuilder, expectedStatusCode int, result interface{}) error {
+	_, err := sendExpecting(ctx, client, builder, expectedStatusCode, result)
+	return err
+}
+
+// Returns the response along with the API errors, so that callers can inspect it.
+func sendExpecting(ctx context.Context, client Client, builder RequestBuilder, expectedStatusCode int, result interface{}) (*Response, error) {
+	apiErr := &apiError{}
+
+	apiReq := builder.
+		WithContext(ctx).
+		WithResultWriteTo(result).
+		WithErrorWriteTo(apiErr).
+		Build()
+
+	response, err := client.Send(apiReq)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != expectedStatusCode {
+		return response, NewErrorFromResponse(response, apiErr.ErrorMessage)
+	}
+
+	return response, nil
+}
+
+// CreateRecord sends the request built so far, which creates a resource, and decodes the created resource into result.
+//
+// The request is sent with an idempotency key, so that it is retried safely. When ctx carries a key set with
+// ContextWithIdempotencyKey, the key is derived from it and from the method and path of the request, so that
+// a retried operation reuses the keys of the first call while its different creates get keys of their own. When the outcome of an earlier attempt is unknown and the API answers that the resource
+// already exists, the resource created by that attempt is fetched from the request path and decoded into result
+// instead of returning the conflict, provided it has the id and the organisation id of the request body.
+func CreateRecord(ctx context.Context, client Client, builder RequestBuilder, result interface{}) error {
+	idempotencyKey, reusedKey := idempotencyKeyFor(ctx, builder)
+
+	builder = builder.WithIdempotencyKey(idempotencyKey)
+
+	response, err := sendExpecting(ctx, client, builder, http.StatusCreated, result)
+
+	if errors.Is(err, ErrConflict) && (reusedKey || response.Attempts > 1) {
+		return reconcileCreate(ctx, client, builder.Build(), result, err)
+	}
+
+	return err
+}
+
+// SendIdempotent sends the request built so far with the idempotency key that CreateRecord would send, and decodes
+// its response into result, provided it has the expected status code. It suits the operations whose outcome is not
+// a resource that can be fetched afterwards, e.g. checks answered with a 200, so that it does not reconcile conflicts.
+func SendIdempotent(ctx context.Context, client Client, builder RequestBuilder, expectedStatusCode int, result interface{}) error {
+	idempotencyKey, _ := idempotencyKeyFor(ctx, builder)
+
+	return SendExpecting(ctx, client, builder.WithIdempotencyKey(idempotencyKey), expectedStatusCode, result)
+}
+
+// Returns the idempotency key of the request, derived from the key of ctx and from the method and path of the request
+// when ctx carries one, which is then reported, or a new key otherwise.
+func idempotencyKeyFor(ctx context.Context, builder RequestBuilder) (string, bool) {
+	idempotencyKey, reusedKey := IdempotencyKeyFromContext(ctx)
+	if !reusedKey {
+		return NewIdempotencyKey(), false
+	}
+
+	apiReq := builder.Build()
+	return fmt.Sprintf("%s-%s%s", idempotencyKey, apiReq.Method, apiReq.Path), true
+}
+
+// Fetches into result the resource that an earlier attempt of apiReq may have created, returning conflictErr when
+// there is no such resource or it belongs to another organisation.
+func reconcileCreate(ctx context.Context, client Client, apiReq *Request, result interface{}, conflictErr error) error {
+	sent, ok := identityOf(apiReq.Body)
+	if !ok || sent.Data.ID == "" {
+		return conflictErr
+	}
+
+	builder := NewRequestBuilder(http.MethodGet).
+		WithPath(apiReq.Path).
+		WithPath(sent.Data.ID)
+
+	if err := SendExpecting(ctx, client, builder, http.StatusOK, result); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return conflictErr
+		}
+		return err
+	}
+
+	existing, ok := identityOf(result)
+	if !ok || existing.Data.ID != sent.Data.ID || existing.Data.OrganisationID != sent.Data.OrganisationID {
+		return conflictErr
+	}
+
+	return nil
+}
+
+// Returns the identity of the resource held by value, reporting false when it holds none.
+func identityOf(value interface{}) (*identity, bool) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+
+	r := &identity{}
+	if err := json.Unmarshal(body, r); err != nil || r.Data == nil {
+		return nil, false
+	}
+
+	return r, true
+}
@@ -0,0 +1,324 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedClient struct {
+	mock.Mock
+}
+
+func (m *MockedClient) Send(req *Request) (*Response, error) {
+	args := m.Called(req)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(*Response), args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+type recordBody struct {
+	Data *recordData `json:"data,omitempty"`
+}
+
+type recordData struct {
+	ID             string `json:"id,omitempty"`
+	OrganisationID string `json:"organisation_id,omitempty"`
+}
+
+func newStatusResponse(statusCode int, attempts int) *Response {
+	return &Response{RawResponse: &http.Response{StatusCode: statusCode}, Attempts: attempts}
+}
+
+func isMethod(method string) interface{} {
+	return mock.MatchedBy(func(req *Request) bool { return req.Method == method })
+}
+
+// Writes the record into the result of the request, as the base client does when decoding the response.
+func writeRecord(id string, organisationID string) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		result := args.Get(0).(*Request).Result.(*recordBody)
+		result.Data = &recordData{ID: id, OrganisationID: organisationID}
+	}
+}
+
+func TestSendExpecting(t *testing.T) {
+	t.Run("Given a response with the expected status code should not return error", func(t *testing.T) {
+		// Arrange
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", mock.Anything).Return(newStatusResponse(200, 1), nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// Act
+		err := SendExpecting(ctx, mockedClient, NewRequestBuilder(http.MethodGet).WithPath("/v1/records"), http.StatusOK, &recordBody{})
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedClient.Calls[0].Arguments.Get(0).(*Request)
+		assert.Equal(t, ctx, apiReq.Context)
+		assert.Equal(t, "/v1/records", apiReq.Path)
+	})
+
+	t.Run("Given a response with another status code should return the respective api error with its message", func(t *testing.T) {
+		// Arrange
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", mock.Anything).Return(newStatusResponse(404, 1), nil).Run(func(args mock.Arguments) {
+			args.Get(0).(*Request).Error.(*apiError).ErrorMessage = "record does not exist"
+		})
+
+		// Act
+		err := SendExpecting(context.Background(), mockedClient, NewRequestBuilder(http.MethodGet), http.StatusOK, &recordBody{})
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrNotFound))
+		assert.Contains(t, err.Error(), "record does not exist")
+	})
+
+	t.Run("Given an error response with a body that is not json should return the respective api error without message", func(t *testing.T) {
+		// Arrange
+		httpResponse := &http.Response{StatusCode: 503, Body: io.NopCloser(strings.NewReader("<html>Service Unavailable</html>"))}
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(httpResponse, nil)
+
+		client := &BaseClient{HttpClient: mockedHttpClient}
+
+		// Act
+		err := SendExpecting(context.Background(), client, NewRequestBuilder(http.MethodGet), http.StatusOK, &recordBody{})
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrServerError))
+		var apiClientError *ApiClientError
+		assert.True(t, errors.As(err, &apiClientError))
+		assert.Empty(t, apiClientError.Message)
+		assert.Equal(t, "<html>Service Unavailable</html>", string(apiClientError.Response.Body()))
+	})
+
+	t.Run("Given an error calling the client should return it", func(t *testing.T) {
+		// Arrange
+		expected := &TransportError{Err: errors.New("connection reset")}
+
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", mock.Anything).Return(nil, expected)
+
+		// Act
+		err := SendExpecting(context.Background(), mockedClient, NewRequestBuilder(http.MethodGet), http.StatusOK, &recordBody{})
+
+		// Assert
+		assert.Same(t, expected, err)
+	})
+}
+
+func TestCreateRecord(t *testing.T) {
+	newBuilder := func(body *recordBody) RequestBuilder {
+		return NewRequestBuilder(http.MethodPost).
+			WithPath("/v1/records").
+			WithBody(body)
+	}
+
+	t.Run("Given a record should send it with a new idempotency key", func(t *testing.T) {
+		// Arrange
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", mock.Anything).Return(newStatusResponse(201, 1), nil)
+
+		// Act
+		err := CreateRecord(context.Background(), mockedClient, newBuilder(&recordBody{}), &recordBody{})
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedClient.Calls[0].Arguments.Get(0).(*Request)
+		assert.NotEmpty(t, apiReq.Headers.Get(IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the record with a key derived from it and from the request", func(t *testing.T) {
+		// Arrange
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", mock.Anything).Return(newStatusResponse(201, 1), nil)
+
+		ctx := ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		err := CreateRecord(ctx, mockedClient, newBuilder(&recordBody{}), &recordBody{})
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedClient.Calls[0].Arguments.Get(0).(*Request)
+		assert.Equal(t, "some_key-POST/v1/records", apiReq.Headers.Get(IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key and creates of different resources should send them with different keys", func(t *testing.T) {
+		// Arrange
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", mock.Anything).Return(newStatusResponse(201, 1), nil)
+
+		ctx := ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		CreateRecord(ctx, mockedClient, newBuilder(&recordBody{}), &recordBody{})
+		CreateRecord(ctx, mockedClient, NewRequestBuilder(http.MethodPost).WithPath("/v1/records/some_id/children"), &recordBody{})
+		CreateRecord(ctx, mockedClient, newBuilder(&recordBody{}), &recordBody{})
+
+		// Assert
+		keys := []string{}
+		for _, call := range mockedClient.Calls {
+			keys = append(keys, call.Arguments.Get(0).(*Request).Headers.Get(IdempotencyKeyHeader))
+		}
+		assert.NotEqual(t, keys[0], keys[1])
+		assert.Equal(t, keys[0], keys[2])
+	})
+
+	t.Run("Given a conflict after a retried attempt should fetch the record created by the earlier attempt", func(t *testing.T) {
+		// Arrange
+		body := &recordBody{Data: &recordData{ID: "some_id", OrganisationID: "some_organisation"}}
+
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", isMethod(http.MethodPost)).Return(newStatusResponse(409, 2), nil)
+		mockedClient.On("Send", isMethod(http.MethodGet)).Return(newStatusResponse(200, 1), nil).
+			Run(writeRecord("some_id", "some_organisation"))
+
+		result := &recordBody{}
+
+		// Act
+		err := CreateRecord(context.Background(), mockedClient, newBuilder(body), result)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, body, result)
+		fetchReq := mockedClient.Calls[1].Arguments.Get(0).(*Request)
+		assert.Equal(t, "/v1/records/some_id", fetchReq.Path)
+	})
+
+	t.Run("Given a conflict with a reused idempotency key should fetch the record created by the earlier attempt", func(t *testing.T) {
+		// Arrange
+		body := &recordBody{Data: &recordData{ID: "some_id"}}
+
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", isMethod(http.MethodPost)).Return(newStatusResponse(409, 1), nil)
+		mockedClient.On("Send", isMethod(http.MethodGet)).Return(newStatusResponse(200, 1), nil).
+			Run(writeRecord("some_id", ""))
+
+		ctx := ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		err := CreateRecord(ctx, mockedClient, newBuilder(body), &recordBody{})
+
+		// Assert
+		assert.Nil(t, err)
+		mockedClient.AssertNumberOfCalls(t, "Send", 2)
+	})
+
+	t.Run("Given a conflict after a retried attempt and a record of another organisation should return the conflict error", func(t *testing.T) {
+		// Arrange
+		body := &recordBody{Data: &recordData{ID: "some_id", OrganisationID: "some_organisation"}}
+
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", isMethod(http.MethodPost)).Return(newStatusResponse(409, 2), nil)
+		mockedClient.On("Send", isMethod(http.MethodGet)).Return(newStatusResponse(200, 1), nil).
+			Run(writeRecord("some_id", "other_organisation"))
+
+		// Act
+		err := CreateRecord(context.Background(), mockedClient, newBuilder(body), &recordBody{})
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrConflict))
+	})
+
+	t.Run("Given a conflict after a retried attempt and no record created should return the conflict error", func(t *testing.T) {
+		// Arrange
+		body := &recordBody{Data: &recordData{ID: "some_id"}}
+
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", isMethod(http.MethodPost)).Return(newStatusResponse(409, 2), nil)
+		mockedClient.On("Send", isMethod(http.MethodGet)).Return(newStatusResponse(404, 1), nil)
+
+		// Act
+		err := CreateRecord(context.Background(), mockedClient, newBuilder(body), &recordBody{})
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrConflict))
+	})
+
+	t.Run("Given a conflict after a retried attempt and a record without id should return the conflict error without fetching", func(t *testing.T) {
+		// Arrange
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", isMethod(http.MethodPost)).Return(newStatusResponse(409, 2), nil)
+
+		// Act
+		err := CreateRecord(context.Background(), mockedClient, newBuilder(&recordBody{}), &recordBody{})
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrConflict))
+		mockedClient.AssertNumberOfCalls(t, "Send", 1)
+	})
+
+	t.Run("Given a conflict on the first attempt should return the conflict error without fetching", func(t *testing.T) {
+		// Arrange
+		body := &recordBody{Data: &recordData{ID: "some_id"}}
+
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", isMethod(http.MethodPost)).Return(newStatusResponse(409, 1), nil)
+
+		// Act
+		err := CreateRecord(context.Background(), mockedClient, newBuilder(body), &recordBody{})
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrConflict))
+		mockedClient.AssertNumberOfCalls(t, "Send", 1)
+	})
+}
+
+func TestSendIdempotent(t *testing.T) {
+	t.Run("Given a request should send it with a new idempotency key", func(t *testing.T) {
+		// Arrange
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", mock.Anything).Return(newStatusResponse(200, 1), nil)
+
+		// Act
+		err := SendIdempotent(context.Background(), mockedClient, NewRequestBuilder(http.MethodPost).WithPath("/v1/checks"), http.StatusOK, &recordBody{})
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedClient.Calls[0].Arguments.Get(0).(*Request)
+		assert.NotEmpty(t, apiReq.Headers.Get(IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the request with a key derived from it and from the request", func(t *testing.T) {
+		// Arrange
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", mock.Anything).Return(newStatusResponse(200, 1), nil)
+
+		ctx := ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		err := SendIdempotent(ctx, mockedClient, NewRequestBuilder(http.MethodPost).WithPath("/v1/checks"), http.StatusOK, &recordBody{})
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedClient.Calls[0].Arguments.Get(0).(*Request)
+		assert.Equal(t, "some_key-POST/v1/checks", apiReq.Headers.Get(IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a conflict should return it without fetching", func(t *testing.T) {
+		// Arrange
+		mockedClient := new(MockedClient)
+		mockedClient.On("Send", mock.Anything).Return(newStatusResponse(409, 2), nil)
+
+		// Act
+		err := SendIdempotent(context.Background(), mockedClient, NewRequestBuilder(http.MethodPost).WithPath("/v1/checks"), http.StatusOK, &recordBody{})
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrConflict))
+		mockedClient.AssertNumberOfCalls(t, "Send", 1)
+	})
+}
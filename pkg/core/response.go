@@ -7,7 +7,11 @@ import (
 
 type Response struct {
 	RawResponse 	*http.Response
+	// Attempts is the number of attempts made to get the response. More than one means that
+	// earlier attempts failed and may still have been processed by the API.
+	Attempts 		int
 	body       		[]byte
+	size 			int64
 }
 
 func (r *Response) UnmarshalJson(target interface{}) error {
@@ -18,6 +22,8 @@ func (r *Response) UnmarshalJson(target interface{}) error {
 	return nil
 }
 
+// Body returns the raw body of the response when it was retained, which is always the case for bodies up to 64KB
+// and, with BaseClient.RetainBody, for bodies up to the maximum response size. It is empty otherwise.
 func (r *Response) Body() []byte {
 	if r.RawResponse == nil {
 		return []byte{}
@@ -25,6 +31,11 @@ func (r *Response) Body() []byte {
 	return r.body
 }
 
+// Size returns the number of bytes of the response body, whether it was retained or not.
+func (r *Response) Size() int64 {
+	return r.size
+}
+
 func (r *Response) Status() string {
 	if r.RawResponse == nil {
 		return ""
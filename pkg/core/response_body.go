@@ -0,0 +1,100 @@
+package core
+
+import (
+	"bytes"
+	"io"
+	"math"
+)
+
+const (
+	// DefaultMaxResponseBytes is the maximum size of the response bodies when BaseClient.MaxResponseBytes is unset.
+	DefaultMaxResponseBytes int64 = 10 << 20
+	// bodies up to this size are always retained, so that Response.Body works for small responses
+	smallResponseBytes int64 = 64 << 10
+)
+
+// maxResponseBytes returns the maximum size of the response bodies, negative when unlimited.
+func (c *BaseClient) maxResponseBytes() int64 {
+	if c.MaxResponseBytes == 0 {
+		return DefaultMaxResponseBytes
+	}
+	return c.MaxResponseBytes
+}
+
+// retainedResponseBytes returns the size up to which the response bodies are retained.
+func (c *BaseClient) retainedResponseBytes() int64 {
+	if !c.RetainBody {
+		return smallResponseBytes
+	}
+	if limit := c.maxResponseBytes(); limit >= 0 {
+		return limit
+	}
+	return math.MaxInt64
+}
+
+// bodyReader reads a response body, failing with ErrResponseTooLarge once more than limit bytes are read.
+// It keeps a copy of the bytes read, unless there are more than retain of them.
+type bodyReader struct {
+	r        io.Reader
+	limit    int64
+	retain   int64
+	size     int64
+	buffer   bytes.Buffer
+	overflow bool
+	tooLarge bool
+	err      error
+}
+
+func newBodyReader(r io.Reader, limit int64, retain int64) *bodyReader {
+	return &bodyReader{r: r, limit: limit, retain: retain}
+}
+
+func (b *bodyReader) Read(p []byte) (int, error) {
+	if b.tooLarge {
+		return 0, ErrResponseTooLarge
+	}
+
+	// reads one byte past the limit, to tell a body of exactly limit bytes apart from a larger one
+	if left := b.limit - b.size + 1; b.limit >= 0 && int64(len(p)) > left {
+		p = p[:left]
+	}
+
+	n, err := b.r.Read(p)
+	if b.limit >= 0 && b.size+int64(n) > b.limit {
+		n = int(b.limit - b.size)
+		b.tooLarge = true
+		err = ErrResponseTooLarge
+	}
+
+	b.size += int64(n)
+	b.keep(p[:n])
+
+	if err != nil && err != io.EOF && !b.tooLarge && b.err == nil {
+		b.err = err
+	}
+
+	return n, err
+}
+
+func (b *bodyReader) keep(p []byte) {
+	if b.overflow {
+		return
+	}
+	if int64(b.buffer.Len()+len(p)) > b.retain {
+		b.overflow = true
+		b.buffer = bytes.Buffer{}
+		return
+	}
+	b.buffer.Write(p)
+}
+
+// retained returns the body read so far, or nil when it was too large to be retained.
+func (b *bodyReader) retained() []byte {
+	if b.overflow {
+		return nil
+	}
+	if b.buffer.Len() == 0 {
+		return []byte{}
+	}
+	return b.buffer.Bytes()
+}
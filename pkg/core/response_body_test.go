@@ -0,0 +1,219 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type sampleResult struct {
+	ID string `json:"id"`
+}
+
+func TestBodyReader(t *testing.T) {
+	t.Run("Given a body within the limit should read it and retain it", func(t *testing.T) {
+		// Arrange
+		sut := newBodyReader(strings.NewReader("0123456789"), 10, 10)
+
+		// Act
+		actual, err := ioutil.ReadAll(sut)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "0123456789", string(actual))
+		assert.Equal(t, "0123456789", string(sut.retained()))
+		assert.Equal(t, int64(10), sut.size)
+		assert.False(t, sut.tooLarge)
+	})
+
+	t.Run("Given a body over the limit should fail with ErrResponseTooLarge", func(t *testing.T) {
+		// Arrange
+		sut := newBodyReader(strings.NewReader("0123456789"), 9, 10)
+
+		// Act
+		actual, err := ioutil.ReadAll(sut)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+		assert.Equal(t, "012345678", string(actual))
+		assert.True(t, sut.tooLarge)
+	})
+
+	t.Run("Given a negative limit should read the whole body", func(t *testing.T) {
+		// Arrange
+		sut := newBodyReader(strings.NewReader(strings.Repeat("x", 1000)), -1, 10)
+
+		// Act
+		actual, err := ioutil.ReadAll(sut)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, actual, 1000)
+		assert.Nil(t, sut.retained())
+	})
+
+	t.Run("Given a body larger than the retained size should not retain it", func(t *testing.T) {
+		// Arrange
+		sut := newBodyReader(strings.NewReader("0123456789"), 100, 5)
+
+		// Act
+		_, err := ioutil.ReadAll(sut)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Nil(t, sut.retained())
+		assert.Equal(t, int64(10), sut.size)
+	})
+
+	t.Run("Given a read error should keep it", func(t *testing.T) {
+		// Arrange
+		sut := newBodyReader(iotest.ErrReader(errors.New("connection reset")), 100, 100)
+
+		// Act
+		_, err := ioutil.ReadAll(sut)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.EqualError(t, sut.err, "connection reset")
+	})
+}
+
+func TestHandleHttpResponseBody(t *testing.T) {
+	newHttpResponse := func(statusCode int, body io.Reader) *http.Response {
+		return &http.Response{StatusCode: statusCode, Body: ioutil.NopCloser(body)}
+	}
+
+	t.Run("Given a small body should decode it and retain it", func(t *testing.T) {
+		// Arrange
+		result := &sampleResult{}
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithResultWriteTo(result).
+			Build()
+
+		sut := &BaseClient{}
+
+		// Act
+		actual, err := sut.handleHttpResponse(apiReq, newHttpResponse(200, strings.NewReader(`{"id":"some_id"}`)))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_id", result.ID)
+		assert.Equal(t, `{"id":"some_id"}`, string(actual.Body()))
+		assert.Equal(t, int64(16), actual.Size())
+	})
+
+	t.Run("Given a body larger than 64KB should decode it without retaining it", func(t *testing.T) {
+		// Arrange
+		body := `{"id":"` + strings.Repeat("x", int(smallResponseBytes)) + `"}`
+		result := &sampleResult{}
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithResultWriteTo(result).
+			Build()
+
+		sut := &BaseClient{}
+
+		// Act
+		actual, err := sut.handleHttpResponse(apiReq, newHttpResponse(200, strings.NewReader(body)))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, result.ID, int(smallResponseBytes))
+		assert.Empty(t, actual.Body())
+		assert.Equal(t, int64(len(body)), actual.Size())
+	})
+
+	t.Run("Given a body larger than 64KB and RetainBody should retain it", func(t *testing.T) {
+		// Arrange
+		body := `{"id":"` + strings.Repeat("x", int(smallResponseBytes)) + `"}`
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithResultWriteTo(&sampleResult{}).
+			Build()
+
+		sut := &BaseClient{RetainBody: true}
+
+		// Act
+		actual, err := sut.handleHttpResponse(apiReq, newHttpResponse(200, strings.NewReader(body)))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, body, string(actual.Body()))
+	})
+
+	t.Run("Given a body larger than the maximum response size should return a ResponseTooLargeError", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithResultWriteTo(&sampleResult{}).
+			Build()
+
+		sut := &BaseClient{MaxResponseBytes: 8}
+
+		// Act
+		actual, err := sut.handleHttpResponse(apiReq, newHttpResponse(200, strings.NewReader(`{"id":"some_id"}`)))
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+		var tooLargeError *ResponseTooLargeError
+		assert.True(t, errors.As(err, &tooLargeError))
+		assert.Equal(t, int64(8), tooLargeError.Limit)
+		assert.Equal(t, 200, tooLargeError.Response.StatusCode())
+	})
+
+	t.Run("Given a body larger than the maximum response size without result should return a ResponseTooLargeError", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodDelete).
+			Build()
+
+		sut := &BaseClient{MaxResponseBytes: 8}
+
+		// Act
+		_, err := sut.handleHttpResponse(apiReq, newHttpResponse(204, strings.NewReader(`{"id":"some_id"}`)))
+
+		// Assert
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+	})
+
+	t.Run("Given an error reading the body should return a TransportError", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithResultWriteTo(&sampleResult{}).
+			Build()
+
+		body := io.MultiReader(strings.NewReader(`{"id":`), iotest.ErrReader(errors.New("connection reset")))
+
+		sut := &BaseClient{}
+
+		// Act
+		actual, err := sut.handleHttpResponse(apiReq, newHttpResponse(200, body))
+
+		// Assert
+		assert.Nil(t, actual)
+		var transportError *TransportError
+		assert.True(t, errors.As(err, &transportError))
+	})
+
+	t.Run("Given an unsuccessful response should decode its body into the error", func(t *testing.T) {
+		// Arrange
+		apiError := &sampleResult{}
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithResultWriteTo(&sampleResult{}).
+			WithErrorWriteTo(apiError).
+			Build()
+
+		sut := &BaseClient{}
+
+		// Act
+		_, err := sut.handleHttpResponse(apiReq, newHttpResponse(404, bytes.NewBufferString(`{"id":"not_found"}`)))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "not_found", apiError.ID)
+	})
+}
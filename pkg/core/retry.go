@@ -0,0 +1,113 @@
+package core
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how a request is retried when an attempt fails with a transport
+// error or with one of the retryable status codes.
+// Requests with non idempotent methods (POST, PATCH) are only retried when they carry an idempotency key.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles on every following retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts. A response asking with Retry-After to wait longer is not retried,
+	// so that its error, e.g. a RateLimitedError with RetryAfter, is returned right away.
+	MaxDelay time.Duration
+	// Jitter is the fraction (between 0 and 1) of the delay that is randomly removed from it.
+	Jitter float64
+	// RetryableStatusCodes are the response status codes that trigger a retry.
+	RetryableStatusCodes []int
+	// RespectRetryAfter waits for the duration given by the Retry-After header when the response has one.
+	RespectRetryAfter bool
+}
+
+// DefaultRetryPolicy returns a policy with 3 attempts retrying on 429, 502, 503 and 504.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    2 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RespectRetryAfter: true,
+	}
+}
+
+// attemptsFor returns the number of attempts allowed for the request.
+func (p *RetryPolicy) attemptsFor(apiReq *Request) int {
+	if p == nil || p.MaxAttempts < 1 || !apiReq.isIdempotent() {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// isRetryableStatus reports whether the status code is one of the retryable status codes.
+func (p *RetryPolicy) isRetryableStatus(statusCode int) bool {
+	if p == nil {
+		return false
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// delay returns how long to wait before the next attempt, given the number of attempts already made
+// and the response of the last one, when there is a response.
+// It reports false when the response asks with Retry-After to wait longer than MaxDelay.
+func (p *RetryPolicy) delay(attempt int, apiResponse *Response) (time.Duration, bool) {
+	if p.RespectRetryAfter && apiResponse != nil && apiResponse.RawResponse != nil {
+		if wait, ok := parseRetryAfter(apiResponse.RawResponse.Header.Get("Retry-After"), time.Now()); ok {
+			return wait, p.MaxDelay <= 0 || wait <= p.MaxDelay
+		}
+	}
+
+	backoff := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && backoff > float64(p.MaxDelay) {
+		backoff = float64(p.MaxDelay)
+	}
+
+	if p.Jitter > 0 {
+		backoff -= backoff * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+
+	return time.Duration(backoff), true
+}
+
+// parseRetryAfter parses the value of a Retry-After header, either in seconds or as an http date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	wait := date.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	return wait, true
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttemptsFor(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3}
+
+	testCases := []struct {
+		method   string
+		expected int
+	}{
+		{http.MethodGet, 3}, {http.MethodDelete, 3}, {http.MethodPut, 3},
+		{http.MethodPost, 1}, {http.MethodPatch, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given a request without idempotency key should only retry idempotent methods", func(t *testing.T) {
+			// Arrange
+			apiReq := NewRequestBuilder(tc.method).Build()
+
+			// Act
+			actual := policy.attemptsFor(apiReq)
+
+			// Assert
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("Given a non idempotent request with idempotency key should allow retries", func(t *testing.T) {
+		// Arrange
+		apiReq := NewRequestBuilder(http.MethodPost).
+			WithHeader(IdempotencyKeyHeader, "some_key").
+			Build()
+
+		// Act
+		actual := policy.attemptsFor(apiReq)
+
+		// Assert
+		assert.Equal(t, 3, actual)
+	})
+
+	t.Run("Given no policy should allow a single attempt", func(t *testing.T) {
+		// Arrange
+		var sut *RetryPolicy
+
+		// Act
+		actual := sut.attemptsFor(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Equal(t, 1, actual)
+	})
+}
+
+func TestDelay(t *testing.T) {
+	t.Run("Given no jitter should double the delay on every attempt up to the max delay", func(t *testing.T) {
+		// Arrange
+		sut := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+		// Act
+		first, _ := sut.delay(1, nil)
+		second, _ := sut.delay(2, nil)
+		third, _ := sut.delay(3, nil)
+		actual := []time.Duration{first, second, third}
+
+		// Assert
+		assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}, actual)
+	})
+
+	t.Run("Given jitter should return a delay within the jitter range", func(t *testing.T) {
+		// Arrange
+		sut := &RetryPolicy{BaseDelay: 100 * time.Millisecond, Jitter: 0.5}
+
+		// Act
+		actual, ok := sut.delay(1, nil)
+
+		// Assert
+		assert.True(t, ok)
+		assert.GreaterOrEqual(t, int64(actual), int64(50*time.Millisecond))
+		assert.LessOrEqual(t, int64(actual), int64(100*time.Millisecond))
+	})
+
+	t.Run("Given a response with Retry-After should return the Retry-After duration", func(t *testing.T) {
+		// Arrange
+		sut := &RetryPolicy{BaseDelay: 100 * time.Millisecond, RespectRetryAfter: true}
+
+		httpResponse := &http.Response{StatusCode: 429, Header: http.Header{}}
+		httpResponse.Header.Set("Retry-After", "2")
+
+		// Act
+		actual, ok := sut.delay(1, &Response{RawResponse: httpResponse})
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, 2*time.Second, actual)
+	})
+
+	t.Run("Given a response with a Retry-After longer than the max delay should not allow the retry", func(t *testing.T) {
+		// Arrange
+		sut := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, RespectRetryAfter: true}
+
+		httpResponse := &http.Response{StatusCode: 429, Header: http.Header{}}
+		httpResponse.Header.Set("Retry-After", "3600")
+
+		// Act
+		_, ok := sut.delay(1, &Response{RawResponse: httpResponse})
+
+		// Assert
+		assert.False(t, ok)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Given seconds should return the respective duration", func(t *testing.T) {
+		// Act
+		actual, ok := parseRetryAfter("5", now)
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, 5*time.Second, actual)
+	})
+
+	t.Run("Given an http date should return the duration until that date", func(t *testing.T) {
+		// Act
+		actual, ok := parseRetryAfter(now.Add(10*time.Second).Format(http.TimeFormat), now)
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, 10*time.Second, actual)
+	})
+
+	t.Run("Given an invalid value should not return a duration", func(t *testing.T) {
+		// Act
+		_, ok := parseRetryAfter("soon", now)
+
+		// Assert
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,240 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/danimagb/api-client/pkg/core"
+
+	requestErrorsName = "api_client.request.errors"
+)
+
+// Error types reported by the spans and the error counter of a Telemetry.
+const (
+	ErrorTypeTransport   = "transport"
+	ErrorTypeTimeout     = "timeout"
+	ErrorTypeDecode      = "decode"
+	ErrorTypeTooLarge    = "response_too_large"
+	ErrorTypeCircuitOpen = "circuit_open"
+	ErrorTypeBadRequest  = "bad_request"
+	ErrorTypeNotFound    = "not_found"
+	ErrorTypeConflict    = "conflict"
+	ErrorTypeRateLimited = "rate_limited"
+	ErrorTypeClient      = "client_error"
+	ErrorTypeServer      = "server_error"
+	ErrorTypeOther       = "_OTHER"
+)
+
+// the boundaries, in seconds, recommended by the OpenTelemetry semantic conventions for http durations
+var durationBoundaries = []float64{0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10}
+
+// Telemetry instruments the requests sent by a BaseClient with OpenTelemetry.
+//
+// Every call to Send is recorded as a client span named after the method and the path template of the request,
+// e.g. "GET /v1/organisation/accounts/{id}", carrying the status code of the response, the number of retries
+// and the error type when it failed. The span context is propagated to the API through the W3C traceparent header.
+//
+// It also records the duration of the calls (http.client.request.duration), the calls in flight
+// (http.client.active_requests) and the failed calls by error type (api_client.request.errors).
+type Telemetry struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	duration   metric.Float64Histogram
+	active     metric.Int64UpDownCounter
+	errors     metric.Int64Counter
+}
+
+// NewTelemetry returns a Telemetry using the given providers, the global ones when nil.
+func NewTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) (*Telemetry, error) {
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+
+	meter := meterProvider.Meter(instrumentationName)
+
+	duration, err := meter.Float64Histogram(semconv.HTTPClientRequestDurationName,
+		metric.WithUnit(semconv.HTTPClientRequestDurationUnit),
+		metric.WithDescription(semconv.HTTPClientRequestDurationDescription),
+		metric.WithExplicitBucketBoundaries(durationBoundaries...))
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := meter.Int64UpDownCounter(semconv.HTTPClientActiveRequestsName,
+		metric.WithUnit(semconv.HTTPClientActiveRequestsUnit),
+		metric.WithDescription(semconv.HTTPClientActiveRequestsDescription))
+	if err != nil {
+		return nil, err
+	}
+
+	errorsCounter, err := meter.Int64Counter(requestErrorsName,
+		metric.WithUnit("{error}"),
+		metric.WithDescription("Number of API calls that failed, by error type."))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Telemetry{
+		tracer:     tracerProvider.Tracer(instrumentationName),
+		propagator: propagation.TraceContext{},
+		duration:   duration,
+		active:     active,
+		errors:     errorsCounter,
+	}, nil
+}
+
+// observation records a single call to Send. A nil observation records nothing.
+type observation struct {
+	ctx        context.Context
+	telemetry  *Telemetry
+	span       trace.Span
+	start      time.Time
+	attributes []attribute.KeyValue
+}
+
+// start opens the span of a call to Send, returning the context to send its attempts with.
+func (t *Telemetry) start(ctx context.Context, apiReq *Request) (context.Context, *observation) {
+	if t == nil {
+		return ctx, nil
+	}
+
+	template := PathTemplate(apiReq.Path)
+	attributes := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(apiReq.Method),
+		semconv.URLTemplate(template),
+	}
+
+	ctx, span := t.tracer.Start(ctx, apiReq.Method+" "+template,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...))
+
+	t.active.Add(ctx, 1, metric.WithAttributes(attributes...))
+
+	return ctx, &observation{
+		ctx:        ctx,
+		telemetry:  t,
+		span:       span,
+		start:      time.Now(),
+		attributes: attributes,
+	}
+}
+
+// inject propagates the span context of ctx into the headers of an attempt.
+func (t *Telemetry) inject(ctx context.Context, header http.Header) {
+	if t == nil {
+		return
+	}
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// attempt records that the attempt-th attempt of the call is about to be made.
+func (o *observation) attempt(attempt int) {
+	if o == nil || attempt < 2 {
+		return
+	}
+	o.span.SetAttributes(semconv.HTTPRequestResendCount(attempt - 1))
+}
+
+// end closes the span of the call and records its metrics.
+func (o *observation) end(apiResponse *Response, err error) {
+	if o == nil {
+		return
+	}
+
+	ctx, t := o.ctx, o.telemetry
+	t.active.Add(ctx, -1, metric.WithAttributes(o.attributes...))
+
+	attributes := o.attributes
+	if statusCode := responseStatusCode(apiResponse, err); statusCode != 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(statusCode))
+	}
+
+	if errorType := telemetryErrorType(apiResponse, err); errorType != "" {
+		attributes = append(attributes, semconv.ErrorTypeKey.String(errorType))
+		t.errors.Add(ctx, 1, metric.WithAttributes(attributes...))
+
+		if err != nil {
+			o.span.RecordError(err)
+			o.span.SetStatus(codes.Error, err.Error())
+		} else {
+			o.span.SetStatus(codes.Error, apiResponse.Status())
+		}
+	}
+
+	t.duration.Record(ctx, time.Since(o.start).Seconds(), metric.WithAttributes(attributes...))
+
+	o.span.SetAttributes(attributes[len(o.attributes):]...)
+	o.span.End()
+}
+
+// Returns the status code of the response of a call, even when its body could not be decoded.
+func responseStatusCode(apiResponse *Response, err error) int {
+	var decodeError *DecodeError
+	var tooLargeError *ResponseTooLargeError
+	if errors.As(err, &decodeError) {
+		apiResponse = decodeError.Response
+	} else if errors.As(err, &tooLargeError) {
+		apiResponse = tooLargeError.Response
+	}
+
+	if apiResponse == nil {
+		return 0
+	}
+	return apiResponse.StatusCode()
+}
+
+// Classifies the outcome of a call, returning an empty string when it succeeded.
+func telemetryErrorType(apiResponse *Response, err error) string {
+	if err != nil {
+		var timeoutError *TimeoutError
+		var transportError *TransportError
+		var decodeError *DecodeError
+
+		switch {
+		case errors.Is(err, ErrCircuitOpen):
+			return ErrorTypeCircuitOpen
+		case errors.As(err, &timeoutError):
+			return ErrorTypeTimeout
+		case errors.As(err, &transportError):
+			return ErrorTypeTransport
+		case errors.As(err, &decodeError):
+			return ErrorTypeDecode
+		case errors.Is(err, ErrResponseTooLarge):
+			return ErrorTypeTooLarge
+		}
+		return ErrorTypeOther
+	}
+
+	if apiResponse == nil || !apiResponse.IsError() {
+		return ""
+	}
+
+	switch statusCode := apiResponse.StatusCode(); {
+	case statusCode == http.StatusBadRequest:
+		return ErrorTypeBadRequest
+	case statusCode == http.StatusNotFound:
+		return ErrorTypeNotFound
+	case statusCode == http.StatusConflict:
+		return ErrorTypeConflict
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorTypeRateLimited
+	case statusCode >= http.StatusInternalServerError:
+		return ErrorTypeServer
+	}
+	return ErrorTypeClient
+}
@@ -0,0 +1,299 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type telemetryRecorder struct {
+	spans   *tracetest.SpanRecorder
+	metrics *sdkmetric.ManualReader
+}
+
+func newTestTelemetry(t *testing.T) (*Telemetry, *telemetryRecorder) {
+	spans := tracetest.NewSpanRecorder()
+	metrics := sdkmetric.NewManualReader()
+
+	telemetry, err := NewTelemetry(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(metrics)))
+	assert.Nil(t, err)
+
+	return telemetry, &telemetryRecorder{spans: spans, metrics: metrics}
+}
+
+// Returns the metric recorded under name, failing the test when there is none.
+func (r *telemetryRecorder) metric(t *testing.T, name string) metricdata.Aggregation {
+	var data metricdata.ResourceMetrics
+	assert.Nil(t, r.metrics.Collect(context.Background(), &data))
+
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name == name {
+				return m.Data
+			}
+		}
+	}
+
+	t.Fatalf("metric %s was not recorded", name)
+	return nil
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestSendWithTelemetry(t *testing.T) {
+	url := url.URL{
+		Scheme: "http",
+		Host:   "example.com",
+	}
+
+	newHttpResponse := func(statusCode int) *http.Response {
+		return &http.Response{StatusCode: statusCode, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}
+	}
+
+	t.Run("Given a successful request should record a client span with its path template and status code", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder := newTestTelemetry(t)
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithPath("/v1/organisation/accounts").
+			WithPath("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc").
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(200), nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Telemetry:  telemetry,
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		spans := recorder.spans.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "GET /v1/organisation/accounts/{id}", spans[0].Name())
+		assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+		assert.Equal(t, "GET", spanAttribute(spans[0], "http.request.method").AsString())
+		assert.Equal(t, "/v1/organisation/accounts/{id}", spanAttribute(spans[0], "url.template").AsString())
+		assert.Equal(t, int64(200), spanAttribute(spans[0], "http.response.status_code").AsInt64())
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	})
+
+	t.Run("Given a request should propagate its span context in the traceparent header", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder := newTestTelemetry(t)
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(200), nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Telemetry:  telemetry,
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		spanContext := recorder.spans.Ended()[0].SpanContext()
+		expected := fmt.Sprintf("00-%s-%s-01", spanContext.TraceID(), spanContext.SpanID())
+		sentRequest := mockedHttpClient.Calls[0].Arguments.Get(0).(*http.Request)
+		assert.Equal(t, expected, sentRequest.Header.Get("traceparent"))
+	})
+
+	t.Run("Given a request retried should record a single span with the resend count", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder := newTestTelemetry(t)
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(503), nil).Once()
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(503), nil).Once()
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(200), nil).Once()
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Telemetry:  telemetry,
+			RetryPolicy: &RetryPolicy{
+				MaxAttempts:          3,
+				BaseDelay:            time.Millisecond,
+				RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+			},
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		spans := recorder.spans.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, int64(2), spanAttribute(spans[0], "http.request.resend_count").AsInt64())
+	})
+
+	t.Run("Given an unsuccessful status code should record the error type on the span and the error counter", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder := newTestTelemetry(t)
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(404), nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Telemetry:  telemetry,
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.Nil(t, err)
+		span := recorder.spans.Ended()[0]
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, ErrorTypeNotFound, spanAttribute(span, "error.type").AsString())
+
+		counter := recorder.metric(t, "api_client.request.errors").(metricdata.Sum[int64])
+		assert.Len(t, counter.DataPoints, 1)
+		assert.Equal(t, int64(1), counter.DataPoints[0].Value)
+		errorType, _ := counter.DataPoints[0].Attributes.Value("error.type")
+		assert.Equal(t, ErrorTypeNotFound, errorType.AsString())
+	})
+
+	t.Run("Given a transport error should record the error on the span", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder := newTestTelemetry(t)
+
+		apiReq := NewRequestBuilder(http.MethodGet).
+			Build()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(nil, fmt.Errorf("connection reset"))
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Telemetry:  telemetry,
+		}
+
+		// Act
+		_, err := sut.Send(apiReq)
+
+		// Assert
+		assert.NotNil(t, err)
+		span := recorder.spans.Ended()[0]
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, ErrorTypeTransport, spanAttribute(span, "error.type").AsString())
+		assert.Len(t, span.Events(), 1)
+	})
+
+	t.Run("Given requests sent should record their duration and leave no request in flight", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder := newTestTelemetry(t)
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(newHttpResponse(200), nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeout:    100,
+			Telemetry:  telemetry,
+		}
+
+		// Act
+		_, firstErr := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+		_, secondErr := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, firstErr)
+		assert.Nil(t, secondErr)
+
+		duration := recorder.metric(t, "http.client.request.duration").(metricdata.Histogram[float64])
+		assert.Len(t, duration.DataPoints, 1)
+		assert.Equal(t, uint64(2), duration.DataPoints[0].Count)
+
+		active := recorder.metric(t, "http.client.active_requests").(metricdata.Sum[int64])
+		assert.Len(t, active.DataPoints, 1)
+		assert.Equal(t, int64(0), active.DataPoints[0].Value)
+	})
+}
+
+func TestTelemetryErrorType(t *testing.T) {
+	newResponse := func(statusCode int) *Response {
+		return &Response{RawResponse: &http.Response{StatusCode: statusCode}}
+	}
+
+	testCases := []struct {
+		name     string
+		response *Response
+		err      error
+		expected string
+	}{
+		{"a successful response", newResponse(200), nil, ""},
+		{"a 400 response", newResponse(400), nil, ErrorTypeBadRequest},
+		{"a 409 response", newResponse(409), nil, ErrorTypeConflict},
+		{"a 429 response", newResponse(429), nil, ErrorTypeRateLimited},
+		{"a 403 response", newResponse(403), nil, ErrorTypeClient},
+		{"a 502 response", newResponse(502), nil, ErrorTypeServer},
+		{"a timeout", nil, &TimeoutError{Err: context.DeadlineExceeded}, ErrorTypeTimeout},
+		{"a decode error", nil, &DecodeError{Err: fmt.Errorf("invalid json"), Response: newResponse(200)}, ErrorTypeDecode},
+		{"a response too large", nil, &ResponseTooLargeError{Limit: 10, Response: newResponse(200)}, ErrorTypeTooLarge},
+		{"an open circuit", nil, &CircuitOpenError{Key: "GET /accounts"}, ErrorTypeCircuitOpen},
+		{"any other error", nil, fmt.Errorf("signing failed"), ErrorTypeOther},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given "+tc.name+" should return '"+tc.expected+"'", func(t *testing.T) {
+			// Act
+			actual := telemetryErrorType(tc.response, tc.err)
+
+			// Assert
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
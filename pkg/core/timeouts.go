@@ -0,0 +1,240 @@
+package core
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPerAttemptTimeout = time.Duration(defaultTimeoutInMilliseconds) * time.Millisecond
+
+	phaseConnect        = "connect"
+	phaseTLSHandshake   = "tls handshake"
+	phaseResponseHeader = "response header"
+)
+
+// Timeouts are the time budgets of a request. A zero budget is unset, leaving the phase bounded
+// by the other budgets only. Every budget is bounded by the deadline of the request context, if any,
+// so that the shortest deadline always wins.
+type Timeouts struct {
+	// Connect bounds the DNS resolution and the dialing of a new connection.
+	Connect time.Duration
+	// TLSHandshake bounds the TLS handshake of a new connection.
+	TLSHandshake time.Duration
+	// ResponseHeader bounds the wait for the response headers once the request is written.
+	ResponseHeader time.Duration
+	// PerAttempt bounds each attempt, from the moment the rate limiter lets it through until its response body is read.
+	// When unset, it defaults to 500ms unless the request context has a deadline or an Operation budget is set.
+	PerAttempt time.Duration
+	// Operation bounds the whole call, all its attempts and the waits between them included.
+	Operation time.Duration
+}
+
+// merge returns t with the budgets set in override replacing its own.
+func (t Timeouts) merge(override *Timeouts) Timeouts {
+	if override == nil {
+		return t
+	}
+	if override.Connect > 0 {
+		t.Connect = override.Connect
+	}
+	if override.TLSHandshake > 0 {
+		t.TLSHandshake = override.TLSHandshake
+	}
+	if override.ResponseHeader > 0 {
+		t.ResponseHeader = override.ResponseHeader
+	}
+	if override.PerAttempt > 0 {
+		t.PerAttempt = override.PerAttempt
+	}
+	if override.Operation > 0 {
+		t.Operation = override.Operation
+	}
+	return t
+}
+
+type timeoutsContextKey struct{}
+
+// ContextWithTimeouts returns a copy of ctx carrying timeouts, which take precedence over the timeouts
+// of the client, of the resource and of the request for the calls made with it.
+func ContextWithTimeouts(ctx context.Context, timeouts Timeouts) context.Context {
+	return context.WithValue(ctx, timeoutsContextKey{}, timeouts)
+}
+
+// TimeoutsFromContext returns the timeouts carried by ctx, if any.
+func TimeoutsFromContext(ctx context.Context) (Timeouts, bool) {
+	if ctx == nil {
+		return Timeouts{}, false
+	}
+	timeouts, ok := ctx.Value(timeoutsContextKey{}).(Timeouts)
+	return timeouts, ok
+}
+
+// ResourceTimeouts are the default timeouts of the requests of a resource client.
+type ResourceTimeouts struct {
+	Timeouts
+	// List replaces the budgets it sets for the requests listing a collection, e.g. to allow them more time.
+	List Timeouts
+}
+
+// WithDefaultTimeouts returns a Client sending the requests through next with the given timeouts
+// as defaults. The timeouts set on a request, with RequestBuilder.WithTimeouts, take precedence over them.
+func WithDefaultTimeouts(next Client, defaults ResourceTimeouts) Client {
+	return &defaultTimeoutsClient{next: next, defaults: defaults}
+}
+
+type defaultTimeoutsClient struct {
+	next     Client
+	defaults ResourceTimeouts
+}
+
+func (c *defaultTimeoutsClient) Send(apiReq *Request) (*Response, error) {
+	timeouts := c.defaults.Timeouts
+	if isListRequest(apiReq) {
+		timeouts = timeouts.merge(&c.defaults.List)
+	}
+
+	timeouts = timeouts.merge(apiReq.Timeouts)
+	apiReq.Timeouts = &timeouts
+
+	return c.next.Send(apiReq)
+}
+
+// Reports whether the request lists a collection, rather than targeting a single record.
+func isListRequest(apiReq *Request) bool {
+	return apiReq.Method == http.MethodGet && !strings.HasSuffix(PathTemplate(apiReq.Path), "{id}")
+}
+
+// Returns the timeouts of a call: the client ones, replaced by those of the request and then of its context.
+func (c *BaseClient) timeoutsFor(ctx context.Context, apiReq *Request) Timeouts {
+	var timeouts Timeouts
+	if c.Timeout > 0 {
+		timeouts.PerAttempt = time.Duration(c.Timeout) * time.Millisecond
+	}
+
+	timeouts = timeouts.merge(c.Timeouts).merge(apiReq.Timeouts)
+
+	if override, ok := TimeoutsFromContext(ctx); ok {
+		timeouts = timeouts.merge(&override)
+	}
+
+	return timeouts
+}
+
+// Returns the context of an attempt, bounded by the per attempt budget and by the budgets of its phases.
+func withAttemptTimeouts(ctx context.Context, timeouts Timeouts) (context.Context, context.CancelFunc) {
+	perAttempt := timeouts.PerAttempt
+	if _, hasDeadline := ctx.Deadline(); perAttempt == 0 && !hasDeadline {
+		perAttempt = defaultPerAttemptTimeout
+	}
+
+	cancelAttempt := func() {}
+	if perAttempt > 0 {
+		ctx, cancelAttempt = context.WithTimeout(ctx, perAttempt)
+	}
+
+	if timeouts.Connect <= 0 && timeouts.TLSHandshake <= 0 && timeouts.ResponseHeader <= 0 {
+		return ctx, cancelAttempt
+	}
+
+	ctx, cancelPhases := context.WithCancelCause(ctx)
+	phases := &phaseTimers{
+		budgets: map[string]time.Duration{
+			phaseConnect:        timeouts.Connect,
+			phaseTLSHandshake:   timeouts.TLSHandshake,
+			phaseResponseHeader: timeouts.ResponseHeader,
+		},
+		timers: map[string]*time.Timer{},
+		cancel: cancelPhases,
+	}
+
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart:     func(httptrace.DNSStartInfo) { phases.start(phaseConnect) },
+		ConnectStart: func(string, string) { phases.start(phaseConnect) },
+		ConnectDone: func(_ string, _ string, err error) {
+			if err == nil {
+				phases.stop(phaseConnect)
+			}
+		},
+		TLSHandshakeStart:    func() { phases.start(phaseTLSHandshake) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { phases.stop(phaseTLSHandshake) },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { phases.start(phaseResponseHeader) },
+		GotFirstResponseByte: func() { phases.stop(phaseResponseHeader) },
+	})
+
+	return ctx, func() {
+		phases.stopAll()
+		cancelPhases(nil)
+		cancelAttempt()
+	}
+}
+
+// phaseTimers cancels the context of an attempt when one of its phases exceeds its budget.
+// Each phase is timed once per attempt, from the first time it starts.
+type phaseTimers struct {
+	mu      sync.Mutex
+	budgets map[string]time.Duration
+	timers  map[string]*time.Timer
+	cancel  context.CancelCauseFunc
+}
+
+func (p *phaseTimers) start(phase string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	budget := p.budgets[phase]
+	if _, started := p.timers[phase]; started || budget <= 0 {
+		return
+	}
+
+	p.timers[phase] = time.AfterFunc(budget, func() {
+		p.cancel(&phaseTimeoutError{phase: phase, timeout: budget})
+	})
+}
+
+func (p *phaseTimers) stop(phase string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if timer := p.timers[phase]; timer != nil {
+		timer.Stop()
+	}
+}
+
+func (p *phaseTimers) stopAll() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, timer := range p.timers {
+		timer.Stop()
+	}
+}
+
+// phaseTimeoutError reports an attempt cancelled because one of its phases exceeded its budget.
+// It matches context.DeadlineExceeded through errors.Is, so that it is reported as a TimeoutError.
+type phaseTimeoutError struct {
+	phase   string
+	timeout time.Duration
+}
+
+func (e *phaseTimeoutError) Error() string {
+	return fmt.Sprintf("%s timeout of %v exceeded", e.phase, e.timeout)
+}
+
+func (e *phaseTimeoutError) Is(target error) bool { return target == context.DeadlineExceeded }
+
+// Returns the phase timeout that cancelled ctx instead of the error it caused, if that is the case.
+func phaseTimeoutCause(ctx context.Context, err error) error {
+	var phaseErr *phaseTimeoutError
+	if errors.As(context.Cause(ctx), &phaseErr) {
+		return phaseErr
+	}
+	return err
+}
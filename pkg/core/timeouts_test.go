@@ -0,0 +1,257 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// recordingClient records the requests it is asked to send.
+type recordingClient struct {
+	requests []*Request
+}
+
+func (c *recordingClient) Send(apiReq *Request) (*Response, error) {
+	c.requests = append(c.requests, apiReq)
+	return &Response{}, nil
+}
+
+// Returns the deadline of the request sent by the mocked client.
+func sentDeadline(mockedHttpClient *MockedHttpClient) (time.Time, bool) {
+	sentRequest := mockedHttpClient.Calls[0].Arguments.Get(0).(*http.Request)
+	return sentRequest.Context().Deadline()
+}
+
+func TestTimeoutsFor(t *testing.T) {
+	t.Run("Given timeouts on the client, the request and the context should replace them in that order", func(t *testing.T) {
+		// Arrange
+		sut := &BaseClient{
+			Timeout:  100,
+			Timeouts: &Timeouts{Connect: time.Second, TLSHandshake: time.Second, ResponseHeader: time.Second},
+		}
+
+		ctx := ContextWithTimeouts(context.Background(), Timeouts{ResponseHeader: 3 * time.Second})
+		apiReq := NewRequestBuilder(http.MethodGet).
+			WithTimeouts(Timeouts{TLSHandshake: 2 * time.Second, ResponseHeader: 2 * time.Second}).
+			WithContext(ctx).
+			Build()
+
+		// Act
+		actual := sut.timeoutsFor(ctx, apiReq)
+
+		// Assert
+		assert.Equal(t, Timeouts{
+			Connect:        time.Second,
+			TLSHandshake:   2 * time.Second,
+			ResponseHeader: 3 * time.Second,
+			PerAttempt:     100 * time.Millisecond,
+		}, actual)
+	})
+}
+
+func TestSendWithTimeouts(t *testing.T) {
+	url := url.URL{
+		Scheme: "http",
+		Host:   "example.com",
+	}
+
+	t.Run("Given no timeouts and a context without deadline should bound the attempt with the default timeout", func(t *testing.T) {
+		// Arrange
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: 200, Body: http.NoBody}, nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+		}
+
+		// Act
+		_, err := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, err)
+		deadline, ok := sentDeadline(mockedHttpClient)
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(defaultPerAttemptTimeout), deadline, 100*time.Millisecond)
+	})
+
+	t.Run("Given no timeouts and a context with a longer deadline should not apply the default timeout", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		expected, _ := ctx.Deadline()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: 200, Body: http.NoBody}, nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+		}
+
+		// Act
+		_, err := sut.Send(NewRequestBuilder(http.MethodGet).WithContext(ctx).Build())
+
+		// Assert
+		assert.Nil(t, err)
+		actual, ok := sentDeadline(mockedHttpClient)
+		assert.True(t, ok)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Given a per attempt timeout longer than the context deadline should keep the context deadline", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		expected, _ := ctx.Deadline()
+
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: 200, Body: http.NoBody}, nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeouts:   &Timeouts{PerAttempt: time.Minute},
+		}
+
+		// Act
+		_, err := sut.Send(NewRequestBuilder(http.MethodGet).WithContext(ctx).Build())
+
+		// Assert
+		assert.Nil(t, err)
+		actual, _ := sentDeadline(mockedHttpClient)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Given an operation timeout should bound all the attempts with it", func(t *testing.T) {
+		// Arrange
+		mockedHttpClient := new(MockedHttpClient)
+		mockedHttpClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: 200, Body: http.NoBody}, nil)
+
+		sut := &BaseClient{
+			BaseUrl:    url,
+			HttpClient: mockedHttpClient,
+			Timeouts:   &Timeouts{Operation: 5 * time.Second},
+		}
+
+		// Act
+		_, err := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, err)
+		deadline, ok := sentDeadline(mockedHttpClient)
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(5*time.Second), deadline, 100*time.Millisecond)
+	})
+
+	t.Run("Given a response header timeout exceeded should return a TimeoutError", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+		}))
+		defer server.Close()
+
+		baseUrl, _ := url.Parse(server.URL)
+
+		sut := &BaseClient{
+			BaseUrl:    *baseUrl,
+			HttpClient: server.Client(),
+			Timeouts:   &Timeouts{PerAttempt: 5 * time.Second, ResponseHeader: 50 * time.Millisecond},
+		}
+
+		// Act
+		_, err := sut.Send(NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		var timeoutError *TimeoutError
+		assert.True(t, errors.As(err, &timeoutError))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "response header timeout of 50ms exceeded")
+	})
+}
+
+func TestWithAttemptTimeouts(t *testing.T) {
+	t.Run("Given a phase exceeding its budget should cancel the attempt with a phase timeout", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := withAttemptTimeouts(context.Background(), Timeouts{PerAttempt: time.Minute, Connect: 10 * time.Millisecond})
+		defer cancel()
+
+		// Act
+		httptrace.ContextClientTrace(ctx).ConnectStart("tcp", "example.com:443")
+		<-ctx.Done()
+
+		// Assert
+		actual := phaseTimeoutCause(ctx, ctx.Err())
+		assert.ErrorIs(t, actual, context.DeadlineExceeded)
+		assert.Equal(t, "connect timeout of 10ms exceeded", actual.Error())
+	})
+
+	t.Run("Given a phase done within its budget should not cancel the attempt", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := withAttemptTimeouts(context.Background(), Timeouts{PerAttempt: time.Minute, Connect: 10 * time.Millisecond})
+		defer cancel()
+		trace := httptrace.ContextClientTrace(ctx)
+
+		// Act
+		trace.ConnectStart("tcp", "example.com:443")
+		trace.ConnectDone("tcp", "example.com:443", nil)
+		time.Sleep(30 * time.Millisecond)
+
+		// Assert
+		assert.Nil(t, ctx.Err())
+	})
+}
+
+func TestWithDefaultTimeouts(t *testing.T) {
+	defaults := ResourceTimeouts{
+		Timeouts: Timeouts{PerAttempt: time.Second, Connect: time.Second},
+		List:     Timeouts{PerAttempt: 5 * time.Second},
+	}
+
+	t.Run("Given a request to a single record should apply the resource defaults", func(t *testing.T) {
+		// Arrange
+		next := &recordingClient{}
+		sut := WithDefaultTimeouts(next, defaults)
+
+		// Act
+		sut.Send(NewRequestBuilder(http.MethodGet).WithPath("/v1/organisation/accounts").WithPath("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc").Build())
+
+		// Assert
+		assert.Equal(t, &Timeouts{PerAttempt: time.Second, Connect: time.Second}, next.requests[0].Timeouts)
+	})
+
+	t.Run("Given a list request should apply the list defaults over the resource defaults", func(t *testing.T) {
+		// Arrange
+		next := &recordingClient{}
+		sut := WithDefaultTimeouts(next, defaults)
+
+		// Act
+		sut.Send(NewRequestBuilder(http.MethodGet).WithPath("/v1/organisation/accounts").Build())
+
+		// Assert
+		assert.Equal(t, &Timeouts{PerAttempt: 5 * time.Second, Connect: time.Second}, next.requests[0].Timeouts)
+	})
+
+	t.Run("Given a request with timeouts should keep them over the resource defaults", func(t *testing.T) {
+		// Arrange
+		next := &recordingClient{}
+		sut := WithDefaultTimeouts(next, defaults)
+
+		// Act
+		sut.Send(NewRequestBuilder(http.MethodPost).WithPath("/v1/organisation/accounts").WithTimeouts(Timeouts{PerAttempt: 2 * time.Second}).Build())
+
+		// Assert
+		assert.Equal(t, &Timeouts{PerAttempt: 2 * time.Second, Connect: time.Second}, next.requests[0].Timeouts)
+	})
+}
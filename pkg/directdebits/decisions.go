@@ -0,0 +1,96 @@
+package directdebits
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+// CreateDecision accepts or rejects an incoming direct debit before it is paid.
+func (dc *DirectDebitsClient) CreateDecision(ctx context.Context, directDebitID uuid.UUID, decision *models.DirectDebitDecisionRequest) (*models.DirectDebitDecisionResponse, error) {
+	decisionResponse := &models.DirectDebitDecisionResponse{}
+
+	if err := dc.create(ctx, directDebitID, decisionsPath, decision, decisionResponse); err != nil {
+		return nil, err
+	}
+
+	return decisionResponse, nil
+}
+
+func (dc *DirectDebitsClient) FetchDecision(ctx context.Context, directDebitID uuid.UUID, decisionID uuid.UUID) (*models.DirectDebitDecisionResponse, error) {
+	decisionResponse := &models.DirectDebitDecisionResponse{}
+
+	if err := dc.fetch(ctx, directDebitID, decisionsPath, decisionID, decisionResponse); err != nil {
+		return nil, err
+	}
+
+	return decisionResponse, nil
+}
+
+// CreateReturn returns a direct debit that was already paid.
+func (dc *DirectDebitsClient) CreateReturn(ctx context.Context, directDebitID uuid.UUID, directDebitReturn *models.DirectDebitReturnRequest) (*models.DirectDebitReturnResponse, error) {
+	returnResponse := &models.DirectDebitReturnResponse{}
+
+	if err := dc.create(ctx, directDebitID, returnsPath, directDebitReturn, returnResponse); err != nil {
+		return nil, err
+	}
+
+	return returnResponse, nil
+}
+
+func (dc *DirectDebitsClient) FetchReturn(ctx context.Context, directDebitID uuid.UUID, returnID uuid.UUID) (*models.DirectDebitReturnResponse, error) {
+	returnResponse := &models.DirectDebitReturnResponse{}
+
+	if err := dc.fetch(ctx, directDebitID, returnsPath, returnID, returnResponse); err != nil {
+		return nil, err
+	}
+
+	return returnResponse, nil
+}
+
+// CreateReversal reverses a direct debit collected by mistake.
+func (dc *DirectDebitsClient) CreateReversal(ctx context.Context, directDebitID uuid.UUID, reversal *models.DirectDebitReversalRequest) (*models.DirectDebitReversalResponse, error) {
+	reversalResponse := &models.DirectDebitReversalResponse{}
+
+	if err := dc.create(ctx, directDebitID, reversalsPath, reversal, reversalResponse); err != nil {
+		return nil, err
+	}
+
+	return reversalResponse, nil
+}
+
+func (dc *DirectDebitsClient) FetchReversal(ctx context.Context, directDebitID uuid.UUID, reversalID uuid.UUID) (*models.DirectDebitReversalResponse, error) {
+	reversalResponse := &models.DirectDebitReversalResponse{}
+
+	if err := dc.fetch(ctx, directDebitID, reversalsPath, reversalID, reversalResponse); err != nil {
+		return nil, err
+	}
+
+	return reversalResponse, nil
+}
+
+// Creates a sub-resource of the direct debit, e.g. /directdebits/{id}/decisions, through the shared idempotent
+// create path, see core.CreateRecord.
+func (dc *DirectDebitsClient) create(ctx context.Context, directDebitID uuid.UUID, subPath string, body interface{}, result interface{}) error {
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseDirectDebitsPath).
+		WithPath(directDebitID.String()).
+		WithPath(subPath).
+		WithBody(body)
+
+	return core.CreateRecord(ctx, dc.baseClient, builder, result)
+}
+
+// Fetches a sub-resource of the direct debit, e.g. /directdebits/{id}/decisions/{decisionId}.
+func (dc *DirectDebitsClient) fetch(ctx context.Context, directDebitID uuid.UUID, subPath string, id uuid.UUID, result interface{}) error {
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseDirectDebitsPath).
+		WithPath(directDebitID.String()).
+		WithPath(subPath).
+		WithPath(id.String())
+
+	return core.SendExpecting(ctx, dc.baseClient, builder, http.StatusOK, result)
+}
@@ -0,0 +1,218 @@
+package directdebits
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDecisions(t *testing.T) {
+	directDebitID, decisionID := uuid.New(), uuid.New()
+
+	t.Run("Given a decision should send it to the direct debit decisions with an idempotency key", func(t *testing.T) {
+		// Arrange
+		decision := &models.DirectDebitDecisionRequest{Data: &models.DirectDebitDecisionData{
+			ID:         decisionID.String(),
+			Type:       "direct_debit_decisions",
+			Attributes: &models.DirectDebitDecisionAttributes{Answer: models.DirectDebitRejected, Reason: "Refer to payer"},
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.CreateDecision(context.Background(), directDebitID, decision)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, baseDirectDebitsPath+"/"+directDebitID.String()+"/decisions", apiReq.Path)
+		assert.Equal(t, decision, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the decision with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.CreateDecision(ctx, directDebitID, &models.DirectDebitDecisionRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+mockedBaseClient.SentRequest(0).Path, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a duplicate conflict after a retried attempt should return the decision created by the earlier attempt", func(t *testing.T) {
+		// Arrange
+		decision := &models.DirectDebitDecisionRequest{Data: &models.DirectDebitDecisionData{ID: decisionID.String()}}
+
+		conflict := coretest.NewResponse(409)
+		conflict.Attempts = 2
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPost)).Return(conflict, nil)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).
+			Run(func(args mock.Arguments) {
+				result := args.Get(0).(*core.Request).Result.(*models.DirectDebitDecisionResponse)
+				result.Data = &models.DirectDebitDecisionData{ID: decisionID.String()}
+			})
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.CreateDecision(context.Background(), directDebitID, decision)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, decisionID.String(), actual.Data.ID)
+		assert.Equal(t, baseDirectDebitsPath+"/"+directDebitID.String()+"/decisions/"+decisionID.String(), mockedBaseClient.SentRequest(1).Path)
+	})
+
+	t.Run("Given a decision id should fetch it from the direct debit decisions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.FetchDecision(context.Background(), directDebitID, decisionID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodGet, mockedBaseClient.SentRequest(0).Method)
+		assert.Equal(t, baseDirectDebitsPath+"/"+directDebitID.String()+"/decisions/"+decisionID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+}
+
+func TestReturns(t *testing.T) {
+	directDebitID, returnID := uuid.New(), uuid.New()
+
+	t.Run("Given a return should send it to the direct debit returns", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.CreateReturn(context.Background(), directDebitID, &models.DirectDebitReturnRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, baseDirectDebitsPath+"/"+directDebitID.String()+"/returns", mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a context with an idempotency key should send the return with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.CreateReturn(ctx, directDebitID, &models.DirectDebitReturnRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+mockedBaseClient.SentRequest(0).Path, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a return id should fetch it from the direct debit returns", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.FetchReturn(context.Background(), directDebitID, returnID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, baseDirectDebitsPath+"/"+directDebitID.String()+"/returns/"+returnID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+}
+
+func TestReversals(t *testing.T) {
+	directDebitID, reversalID := uuid.New(), uuid.New()
+
+	t.Run("Given a reversal should send it to the direct debit reversals", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.CreateReversal(context.Background(), directDebitID, &models.DirectDebitReversalRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, baseDirectDebitsPath+"/"+directDebitID.String()+"/reversals", mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a context with an idempotency key should send the reversal with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.CreateReversal(ctx, directDebitID, &models.DirectDebitReversalRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+mockedBaseClient.SentRequest(0).Path, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a reversal id should fetch it from the direct debit reversals", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.FetchReversal(context.Background(), directDebitID, reversalID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, baseDirectDebitsPath+"/"+directDebitID.String()+"/reversals/"+reversalID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code other than 201 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(409), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.CreateReversal(context.Background(), directDebitID, &models.DirectDebitReversalRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+	})
+}
@@ -0,0 +1,87 @@
+// Package directdebits provides the client of the direct debits API: the direct debits collected under
+// a mandate and the decisions, returns and reversals taken on them.
+package directdebits
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	baseDirectDebitsPath string = "/v1/transaction/directdebits"
+	decisionsPath        string = "decisions"
+	returnsPath          string = "returns"
+	reversalsPath        string = "reversals"
+)
+
+type DirectDebitsClient struct {
+	baseClient core.Client
+}
+
+func New(baseClient core.Client) *DirectDebitsClient {
+	return &DirectDebitsClient{
+		baseClient: baseClient,
+	}
+}
+
+func (dc *DirectDebitsClient) Fetch(ctx context.Context, id uuid.UUID) (*models.DirectDebitResponse, error) {
+	directDebitResponse := &models.DirectDebitResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseDirectDebitsPath).
+		WithPath(id.String())
+
+	if err := core.SendExpecting(ctx, dc.baseClient, builder, http.StatusOK, directDebitResponse); err != nil {
+		return nil, err
+	}
+
+	return directDebitResponse, nil
+}
+
+// ListOptions holds the paging and filtering parameters used when listing direct debits.
+type ListOptions struct {
+	core.PageOptions
+	Filter ListFilter
+}
+
+// ListFilter restricts the direct debits returned by List. Slice fields accept multiple values and
+// processing dates use the YYYY-MM-DD format.
+type ListFilter struct {
+	Status             []string
+	Reference          []string
+	MandateID          []string
+	ProcessingDateFrom string
+	ProcessingDateTo   string
+}
+
+// List returns a single page of direct debits together with the links to the surrounding pages.
+func (dc *DirectDebitsClient) List(ctx context.Context, opts *ListOptions) (*models.DirectDebitListResponse, error) {
+	listResponse := &models.DirectDebitListResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseDirectDebitsPath)
+
+	if opts != nil {
+		builder = opts.apply(builder)
+	}
+
+	if err := core.SendExpecting(ctx, dc.baseClient, builder, http.StatusOK, listResponse); err != nil {
+		return nil, err
+	}
+
+	return listResponse, nil
+}
+
+func (opts *ListOptions) apply(builder core.RequestBuilder) core.RequestBuilder {
+	return core.ApplyList(builder, opts.PageOptions, core.ListFilters{
+		"filter[status]":               opts.Filter.Status,
+		"filter[reference]":            opts.Filter.Reference,
+		"filter[mandate_id]":           opts.Filter.MandateID,
+		"filter[processing_date_from]": {opts.Filter.ProcessingDateFrom},
+		"filter[processing_date_to]":   {opts.Filter.ProcessingDateTo},
+	})
+}
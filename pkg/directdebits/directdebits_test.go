@@ -0,0 +1,113 @@
+package directdebits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetch(t *testing.T) {
+	t.Run("Given an error calling base client should return an error", func(t *testing.T) {
+		// Arrange
+		expectedError := fmt.Errorf("Some error occurred")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given a response with status code 200 should return the direct debit", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), id)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, http.MethodGet, mockedBaseClient.SentRequest(0).Method)
+		assert.Equal(t, baseDirectDebitsPath+"/"+id.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code 404 should return a not found error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(404), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("Given paging and filters should send them as query parameters", func(t *testing.T) {
+		// Arrange
+		mandateID := uuid.NewString()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		opts := &ListOptions{
+			PageOptions: core.PageOptions{PageNumber: 1},
+			Filter:      ListFilter{MandateID: []string{mandateID}, ProcessingDateFrom: "2021-03-01"},
+		}
+
+		// Act
+		actual, err := sut.List(context.Background(), opts)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, baseDirectDebitsPath, apiReq.Path)
+		assert.Equal(t, "1", apiReq.QueryParam.Get("page[number]"))
+		assert.Equal(t, []string{mandateID}, apiReq.QueryParam["filter[mandate_id]"])
+		assert.Equal(t, "2021-03-01", apiReq.QueryParam.Get("filter[processing_date_from]"))
+		assert.NotContains(t, apiReq.QueryParam, "filter[processing_date_to]")
+	})
+
+	t.Run("Given a response with status code other than 200 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(503), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.List(context.Background(), nil)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrServerError))
+	})
+}
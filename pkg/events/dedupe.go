@@ -0,0 +1,78 @@
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultDeduplicationWindow time.Duration = 24 * time.Hour
+	evictionInterval           time.Duration = time.Minute
+)
+
+// Deduplicator remembers the ids of the events already processed, so that redelivered events are dropped.
+// Implementations backed by a shared store allow several receivers to deduplicate together.
+type Deduplicator interface {
+	// MarkSeen records the event id and reports whether it had already been recorded.
+	MarkSeen(id string) bool
+	// Forget removes the event id, so that a redelivery of an event that failed is processed again.
+	Forget(id string)
+}
+
+// MemoryDeduplicator is a Deduplicator keeping the event ids in memory for a fixed window.
+// It is safe for concurrent use.
+type MemoryDeduplicator struct {
+	mu     sync.Mutex
+	window time.Duration
+	seen   map[string]time.Time
+	// expired ids are evicted at most once per evictionInterval, so that MarkSeen stays cheap
+	lastEviction time.Time
+	now          func() time.Time
+}
+
+// NewMemoryDeduplicator returns a MemoryDeduplicator remembering event ids for window,
+// or for 24 hours when window is not positive.
+func NewMemoryDeduplicator(window time.Duration) *MemoryDeduplicator {
+	if window <= 0 {
+		window = defaultDeduplicationWindow
+	}
+
+	return &MemoryDeduplicator{
+		window: window,
+		seen:   map[string]time.Time{},
+		now:    time.Now,
+	}
+}
+
+func (d *MemoryDeduplicator) MarkSeen(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	if now.Sub(d.lastEviction) >= evictionInterval {
+		d.evictExpired(now)
+		d.lastEviction = now
+	}
+
+	if expiry, seen := d.seen[id]; seen && now.Before(expiry) {
+		return true
+	}
+
+	d.seen[id] = now.Add(d.window)
+	return false
+}
+
+func (d *MemoryDeduplicator) Forget(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.seen, id)
+}
+
+func (d *MemoryDeduplicator) evictExpired(now time.Time) {
+	for id, expiry := range d.seen {
+		if !now.Before(expiry) {
+			delete(d.seen, id)
+		}
+	}
+}
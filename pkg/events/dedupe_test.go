@@ -0,0 +1,64 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkSeen(t *testing.T) {
+	t.Run("Given an id seen for the first time should report it as not seen", func(t *testing.T) {
+		// Arrange
+		sut := NewMemoryDeduplicator(time.Hour)
+
+		// Act
+		actual := sut.MarkSeen("event-1")
+
+		// Assert
+		assert.False(t, actual)
+	})
+
+	t.Run("Given an id seen within the window should report it as seen", func(t *testing.T) {
+		// Arrange
+		sut := NewMemoryDeduplicator(time.Hour)
+		sut.MarkSeen("event-1")
+
+		// Act
+		actual := sut.MarkSeen("event-1")
+
+		// Assert
+		assert.True(t, actual)
+	})
+
+	t.Run("Given an id seen before the window should report it as not seen and evict it", func(t *testing.T) {
+		// Arrange
+		now := time.Now()
+		sut := NewMemoryDeduplicator(time.Hour)
+		sut.now = func() time.Time { return now }
+		sut.MarkSeen("event-1")
+		sut.MarkSeen("event-2")
+		now = now.Add(2 * time.Hour)
+
+		// Act
+		actual := sut.MarkSeen("event-1")
+
+		// Assert
+		assert.False(t, actual)
+		assert.NotContains(t, sut.seen, "event-2")
+	})
+
+	t.Run("Given a forgotten id should report it as not seen", func(t *testing.T) {
+		// Arrange
+		sut := NewMemoryDeduplicator(0)
+		sut.MarkSeen("event-1")
+		sut.Forget("event-1")
+
+		// Act
+		actual := sut.MarkSeen("event-1")
+
+		// Assert
+		assert.False(t, actual)
+		assert.Equal(t, defaultDeduplicationWindow, sut.window)
+	})
+}
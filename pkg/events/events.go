@@ -0,0 +1,92 @@
+// Package events receives the notifications sent to the callbacks registered with the subscriptions client.
+// Its Handler verifies the signature of every notification, drops the ones already delivered and dispatches
+// the others, decoded into typed events, to the functions registered for their record and event type.
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/danimagb/api-client/pkg/models"
+)
+
+// Record types that events are sent for.
+const (
+	RecordTypeAccounts           string = "accounts"
+	RecordTypePayments           string = "payments"
+	RecordTypePaymentSubmissions string = "payment_submissions"
+)
+
+// Event types of the changes made to a record.
+const (
+	EventTypeCreated string = "created"
+	EventTypeUpdated string = "updated"
+	EventTypeDeleted string = "deleted"
+	// AnyEventType registers a function for every event type of a record type.
+	AnyEventType string = "*"
+)
+
+// Event is the notification sent to a subscription callback. Data holds the record that changed,
+// which is decoded by the typed events such as AccountEvent.
+type Event struct {
+	ID             string          `json:"id"`
+	OrganisationID string          `json:"organisation_id,omitempty"`
+	EventType      string          `json:"event_type"`
+	RecordType     string          `json:"record_type"`
+	Version        int64           `json:"version,omitempty"`
+	CreatedOn      string          `json:"created_on,omitempty"`
+	Data           json.RawMessage `json:"data,omitempty"`
+}
+
+// Decode unmarshals the record carried by the event into target.
+func (e *Event) Decode(target interface{}) error {
+	if len(e.Data) == 0 {
+		return fmt.Errorf("event %s has no data", e.ID)
+	}
+	if err := json.Unmarshal(e.Data, target); err != nil {
+		return fmt.Errorf("error decoding data of event %s: %w", e.ID, err)
+	}
+	return nil
+}
+
+// AccountEvent is an event on an account, e.g. the account being created or its status being updated.
+type AccountEvent struct {
+	*Event
+	Account *models.AccountData
+}
+
+// PaymentEvent is an event on a payment.
+type PaymentEvent struct {
+	*Event
+	Payment *models.PaymentData
+}
+
+// PaymentSubmissionEvent is an event on a payment submission, e.g. its status being updated by the scheme.
+type PaymentSubmissionEvent struct {
+	*Event
+	Submission *models.PaymentSubmissionData
+}
+
+func newAccountEvent(event *Event) (*AccountEvent, error) {
+	account := &models.AccountData{}
+	if err := event.Decode(account); err != nil {
+		return nil, err
+	}
+	return &AccountEvent{Event: event, Account: account}, nil
+}
+
+func newPaymentEvent(event *Event) (*PaymentEvent, error) {
+	payment := &models.PaymentData{}
+	if err := event.Decode(payment); err != nil {
+		return nil, err
+	}
+	return &PaymentEvent{Event: event, Payment: payment}, nil
+}
+
+func newPaymentSubmissionEvent(event *Event) (*PaymentSubmissionEvent, error) {
+	submission := &models.PaymentSubmissionData{}
+	if err := event.Decode(submission); err != nil {
+		return nil, err
+	}
+	return &PaymentSubmissionEvent{Event: event, Submission: submission}, nil
+}
@@ -0,0 +1,46 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	t.Run("Given an event with data should decode it into the target", func(t *testing.T) {
+		// Arrange
+		sut := &Event{ID: "event-1", Data: json.RawMessage(`{"id":"account-1","type":"accounts"}`)}
+
+		// Act
+		account, err := newAccountEvent(sut)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "account-1", account.Account.ID)
+		assert.Equal(t, "event-1", account.ID)
+	})
+
+	t.Run("Given an event without data should return an error", func(t *testing.T) {
+		// Arrange
+		sut := &Event{ID: "event-1"}
+
+		// Act
+		err := sut.Decode(&struct{}{})
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Given an event with data of the wrong shape should return an error", func(t *testing.T) {
+		// Arrange
+		sut := &Event{ID: "event-1", Data: json.RawMessage(`["not", "a", "payment"]`)}
+
+		// Act
+		payment, err := newPaymentEvent(sut)
+
+		// Assert
+		assert.Nil(t, payment)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,202 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/danimagb/api-client/pkg/signing"
+)
+
+const (
+	defaultMaxBodyBytes int64 = 1 << 20
+)
+
+// HandlerFunc processes an event. Returning an error makes the receiver answer 500,
+// so that the notification is delivered again later.
+type HandlerFunc func(ctx context.Context, event *Event) error
+
+// Handler is the http.Handler serving a subscription callback.
+//
+//	handler := events.NewHandler(signing.NewVerifier(resolveKey))
+//	handler.OnAccount(events.EventTypeCreated, func(ctx context.Context, event *events.AccountEvent) error {...})
+//	http.Handle("/callbacks", handler)
+//
+// Notifications are acknowledged with 200 once processed, or right away when they were already processed
+// or have no registered function. Notifications with an invalid signature are rejected with 401.
+type Handler struct {
+	verifier     *signing.Verifier
+	deduplicator Deduplicator
+	maxBodyBytes int64
+
+	mu       sync.RWMutex
+	handlers map[string]HandlerFunc
+}
+
+type Option func(*Handler)
+
+// WithDeduplicator replaces the in-memory deduplicator, e.g. by one shared between several receivers.
+func WithDeduplicator(deduplicator Deduplicator) Option {
+	return func(h *Handler) {
+		h.deduplicator = deduplicator
+	}
+}
+
+// WithMaxBodyBytes limits the size of the notifications, 1MB by default.
+func WithMaxBodyBytes(maxBodyBytes int64) Option {
+	return func(h *Handler) {
+		h.maxBodyBytes = maxBodyBytes
+	}
+}
+
+// NewHandler returns a Handler verifying notifications with verifier. A nil verifier disables
+// the verification, which is only meant for tests.
+func NewHandler(verifier *signing.Verifier, options ...Option) *Handler {
+	h := &Handler{
+		verifier:     verifier,
+		deduplicator: NewMemoryDeduplicator(defaultDeduplicationWindow),
+		maxBodyBytes: defaultMaxBodyBytes,
+		handlers:     map[string]HandlerFunc{},
+	}
+
+	for _, option := range options {
+		option(h)
+	}
+
+	return h
+}
+
+// On registers fn for the events of eventType on records of recordType, replacing any function registered
+// for the same types. AnyEventType registers fn for the event types without a function of their own.
+func (h *Handler) On(recordType string, eventType string, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[handlerKey(recordType, eventType)] = fn
+}
+
+// OnAccount registers fn for the events of eventType on accounts.
+func (h *Handler) OnAccount(eventType string, fn func(ctx context.Context, event *AccountEvent) error) {
+	h.On(RecordTypeAccounts, eventType, func(ctx context.Context, event *Event) error {
+		accountEvent, err := newAccountEvent(event)
+		if err != nil {
+			return &decodeError{err}
+		}
+		return fn(ctx, accountEvent)
+	})
+}
+
+// OnPayment registers fn for the events of eventType on payments.
+func (h *Handler) OnPayment(eventType string, fn func(ctx context.Context, event *PaymentEvent) error) {
+	h.On(RecordTypePayments, eventType, func(ctx context.Context, event *Event) error {
+		paymentEvent, err := newPaymentEvent(event)
+		if err != nil {
+			return &decodeError{err}
+		}
+		return fn(ctx, paymentEvent)
+	})
+}
+
+// OnPaymentSubmission registers fn for the events of eventType on payment submissions.
+func (h *Handler) OnPaymentSubmission(eventType string, fn func(ctx context.Context, event *PaymentSubmissionEvent) error) {
+	h.On(RecordTypePaymentSubmissions, eventType, func(ctx context.Context, event *Event) error {
+		submissionEvent, err := newPaymentSubmissionEvent(event)
+		if err != nil {
+			return &decodeError{err}
+		}
+		return fn(ctx, submissionEvent)
+	})
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxBodyBytes)
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "error reading notification body", http.StatusRequestEntityTooLarge)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if h.verifier != nil {
+		if err := h.verifier.Verify(r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	event := &Event{}
+	if err := json.Unmarshal(body, event); err != nil || event.ID == "" {
+		http.Error(w, "invalid notification body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Dispatch(r.Context(), event); err != nil {
+		var decodeErr *decodeError
+		if errors.As(err, &decodeErr) {
+			http.Error(w, decodeErr.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "error processing notification", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Dispatch calls the function registered for the event, unless the event was already processed.
+// When the function fails, the event is forgotten so that its redelivery is processed again.
+func (h *Handler) Dispatch(ctx context.Context, event *Event) error {
+	fn := h.handlerFor(event)
+	if fn == nil {
+		return nil
+	}
+
+	if h.deduplicator.MarkSeen(event.ID) {
+		return nil
+	}
+
+	if err := fn(ctx, event); err != nil {
+		h.deduplicator.Forget(event.ID)
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) handlerFor(event *Event) HandlerFunc {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if fn, ok := h.handlers[handlerKey(event.RecordType, event.EventType)]; ok {
+		return fn
+	}
+	return h.handlers[handlerKey(event.RecordType, AnyEventType)]
+}
+
+func handlerKey(recordType string, eventType string) string {
+	return recordType + "/" + eventType
+}
+
+// decodeError reports a notification whose data does not match its record type.
+type decodeError struct {
+	err error
+}
+
+func (e *decodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
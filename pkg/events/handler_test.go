@@ -0,0 +1,229 @@
+package events
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/signing"
+	"github.com/stretchr/testify/assert"
+)
+
+const accountCreated = `{"id":"event-1","event_type":"created","record_type":"accounts","data":{"id":"account-1","type":"accounts"}}`
+
+func newSignedRequest(t *testing.T, key ed25519.PrivateKey, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/callbacks", strings.NewReader(body))
+
+	signer, err := signing.NewSigner("key-1", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := signer.SignRequest(req); err != nil {
+		t.Fatal(err)
+	}
+
+	return req
+}
+
+func newVerifier(publicKey ed25519.PublicKey) *signing.Verifier {
+	return signing.NewVerifier(func(keyID string) (crypto.PublicKey, error) {
+		if keyID != "key-1" {
+			return nil, fmt.Errorf("key not found")
+		}
+		return publicKey, nil
+	})
+}
+
+func TestServeHTTP(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	t.Run("Given a signed notification should dispatch the typed event and answer 200", func(t *testing.T) {
+		// Arrange
+		var received *AccountEvent
+
+		sut := NewHandler(newVerifier(publicKey))
+		sut.OnAccount(EventTypeCreated, func(ctx context.Context, event *AccountEvent) error {
+			received = event
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(recorder, newSignedRequest(t, privateKey, accountCreated))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.NotNil(t, received)
+		assert.Equal(t, "event-1", received.ID)
+		assert.Equal(t, "account-1", received.Account.ID)
+	})
+
+	t.Run("Given a notification signed with an unknown key should answer 401 without dispatching", func(t *testing.T) {
+		// Arrange
+		_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+		dispatched := false
+
+		sut := NewHandler(newVerifier(publicKey))
+		sut.On(RecordTypeAccounts, AnyEventType, func(ctx context.Context, event *Event) error {
+			dispatched = true
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(recorder, newSignedRequest(t, otherKey, accountCreated))
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.False(t, dispatched)
+	})
+
+	t.Run("Given a notification with a tampered body should answer 401", func(t *testing.T) {
+		// Arrange
+		req := newSignedRequest(t, privateKey, accountCreated)
+		req.Body = http.NoBody
+		req.ContentLength = 0
+
+		sut := NewHandler(newVerifier(publicKey))
+
+		recorder := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(recorder, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("Given a redelivered notification should dispatch it only once", func(t *testing.T) {
+		// Arrange
+		calls := 0
+
+		sut := NewHandler(newVerifier(publicKey))
+		sut.OnAccount(EventTypeCreated, func(ctx context.Context, event *AccountEvent) error {
+			calls++
+			return nil
+		})
+
+		first := httptest.NewRecorder()
+		second := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(first, newSignedRequest(t, privateKey, accountCreated))
+		sut.ServeHTTP(second, newSignedRequest(t, privateKey, accountCreated))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusOK, second.Code)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Given a failing handler should answer 500 and process the redelivery", func(t *testing.T) {
+		// Arrange
+		calls := 0
+
+		sut := NewHandler(nil)
+		sut.OnAccount(EventTypeCreated, func(ctx context.Context, event *AccountEvent) error {
+			calls++
+			if calls == 1 {
+				return fmt.Errorf("Some error occurred")
+			}
+			return nil
+		})
+
+		first := httptest.NewRecorder()
+		second := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(first, httptest.NewRequest(http.MethodPost, "/callbacks", strings.NewReader(accountCreated)))
+		sut.ServeHTTP(second, httptest.NewRequest(http.MethodPost, "/callbacks", strings.NewReader(accountCreated)))
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, first.Code)
+		assert.Equal(t, http.StatusOK, second.Code)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Given a notification for a record type without handler should answer 200", func(t *testing.T) {
+		// Arrange
+		body := `{"id":"event-2","event_type":"created","record_type":"payments","data":{"id":"payment-1"}}`
+
+		sut := NewHandler(nil)
+		sut.OnAccount(AnyEventType, func(ctx context.Context, event *AccountEvent) error {
+			return fmt.Errorf("should not be called")
+		})
+
+		recorder := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/callbacks", strings.NewReader(body)))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("Given data not matching the record type should answer 400", func(t *testing.T) {
+		// Arrange
+		body := `{"id":"event-3","event_type":"updated","record_type":"payments","data":"not a payment"}`
+
+		sut := NewHandler(nil)
+		sut.OnPayment(EventTypeUpdated, func(ctx context.Context, event *PaymentEvent) error {
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/callbacks", strings.NewReader(body)))
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Given a body without event id should answer 400", func(t *testing.T) {
+		// Arrange
+		sut := NewHandler(nil)
+
+		recorder := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/callbacks", strings.NewReader(`{"record_type":"accounts"}`)))
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Given a body over the limit should answer 413", func(t *testing.T) {
+		// Arrange
+		sut := NewHandler(nil, WithMaxBodyBytes(16))
+
+		recorder := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/callbacks", strings.NewReader(accountCreated)))
+
+		// Assert
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+
+	t.Run("Given a method other than POST should answer 405", func(t *testing.T) {
+		// Arrange
+		sut := NewHandler(nil)
+
+		recorder := httptest.NewRecorder()
+
+		// Act
+		sut.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/callbacks", nil))
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	})
+}
@@ -0,0 +1,337 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageSize int = 100
+	maxPageSize     int = 1000
+)
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	request := &models.AccountRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	if failures := validateAccount(request.Data); len(failures) > 0 {
+		writeError(w, http.StatusBadRequest, "validation failure list:\nvalidation failure list:\n"+strings.Join(failures, "\n"))
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, exists := h.accounts[request.Data.ID]; exists {
+		writeError(w, http.StatusConflict, "Account cannot be created as it violates a duplicate constraint")
+		return
+	}
+
+	account := copyAccount(request.Data)
+	account.Version = new(int64)
+
+	h.accounts[account.ID] = account
+	h.order = append(h.order, account.ID)
+
+	writeJSON(w, http.StatusCreated, &models.AccountResponse{Data: copyAccount(account), Links: selfLink(account.ID)})
+}
+
+func (h *Handler) fetch(w http.ResponseWriter, id string) {
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, http.StatusBadRequest, "id is not a valid uuid")
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	account, ok := h.accounts[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("record %s does not exist", id))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &models.AccountResponse{Data: copyAccount(account), Links: selfLink(id)})
+}
+
+func (h *Handler) update(w http.ResponseWriter, r *http.Request, id string) {
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, http.StatusBadRequest, "id is not a valid uuid")
+		return
+	}
+
+	request := &models.AccountPatchRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	if request.Data == nil || request.Data.Version == nil {
+		writeError(w, http.StatusBadRequest, "validation failure list:\nvalidation failure list:\ndata.version in body is required")
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	account, ok := h.accounts[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("record %s does not exist", id))
+		return
+	}
+
+	if *account.Version != *request.Data.Version {
+		writeError(w, http.StatusConflict, "invalid version")
+		return
+	}
+
+	updated := copyAccount(account)
+	applyPatch(updated, request.Data.Attributes)
+
+	if failures := validateAccount(updated); len(failures) > 0 {
+		writeError(w, http.StatusBadRequest, "validation failure list:\nvalidation failure list:\n"+strings.Join(failures, "\n"))
+		return
+	}
+
+	*updated.Version++
+	h.accounts[id] = updated
+
+	writeJSON(w, http.StatusOK, &models.AccountResponse{Data: copyAccount(updated), Links: selfLink(id)})
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request, id string) {
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, http.StatusBadRequest, "id is not a valid uuid")
+		return
+	}
+
+	version, err := strconv.ParseInt(r.URL.Query().Get("version"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid version number")
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	account, ok := h.accounts[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("record %s does not exist", id))
+		return
+	}
+
+	if *account.Version != version {
+		writeError(w, http.StatusConflict, "invalid version")
+		return
+	}
+
+	delete(h.accounts, id)
+	for i, existing := range h.order {
+		if existing == id {
+			h.order = append(h.order[:i], h.order[i+1:]...)
+			break
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	pageNumber, pageSize, err := parsePage(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filters := parseFilters(query)
+
+	h.mu.Lock()
+	matching := []*models.AccountData{}
+	for _, id := range h.order {
+		if account := h.accounts[id]; matchesFilters(account, filters) {
+			matching = append(matching, copyAccount(account))
+		}
+	}
+	h.mu.Unlock()
+
+	lastPage := 0
+	if len(matching) > 0 {
+		lastPage = (len(matching) - 1) / pageSize
+	}
+
+	start := pageNumber * pageSize
+	if start > len(matching) {
+		start = len(matching)
+	}
+	end := start + pageSize
+	if end > len(matching) {
+		end = len(matching)
+	}
+
+	links := &models.Links{
+		Self:  pageLink(query, pageNumber, pageSize),
+		First: pageLink(query, 0, pageSize),
+		Last:  pageLink(query, lastPage, pageSize),
+	}
+	if pageNumber < lastPage {
+		links.Next = pageLink(query, pageNumber+1, pageSize)
+	}
+	if pageNumber > 0 && pageNumber <= lastPage {
+		links.Prev = pageLink(query, pageNumber-1, pageSize)
+	}
+
+	writeJSON(w, http.StatusOK, &models.AccountListResponse{Data: matching[start:end], Links: links})
+}
+
+func parsePage(query url.Values) (int, int, error) {
+	pageNumber, pageSize := 0, defaultPageSize
+
+	if value := query.Get("page[number]"); value != "" {
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 0 {
+			return 0, 0, fmt.Errorf("page[number] must be a non negative integer")
+		}
+		pageNumber = number
+	}
+
+	if value := query.Get("page[size]"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 || size > maxPageSize {
+			return 0, 0, fmt.Errorf("page[size] must be an integer between 1 and %d", maxPageSize)
+		}
+		pageSize = size
+	}
+
+	return pageNumber, pageSize, nil
+}
+
+// Collects the filter[...] query parameters, accepting repeated and comma separated values.
+func parseFilters(query url.Values) map[string][]string {
+	filters := map[string][]string{}
+
+	for param, values := range query {
+		if !strings.HasPrefix(param, "filter[") || !strings.HasSuffix(param, "]") {
+			continue
+		}
+		field := strings.TrimSuffix(strings.TrimPrefix(param, "filter["), "]")
+		for _, value := range values {
+			filters[field] = append(filters[field], strings.Split(value, ",")...)
+		}
+	}
+
+	return filters
+}
+
+func matchesFilters(account *models.AccountData, filters map[string][]string) bool {
+	attributes := account.Attributes
+	if attributes == nil {
+		attributes = &models.AccountAttributes{}
+	}
+
+	country := ""
+	if attributes.Country != nil {
+		country = *attributes.Country
+	}
+
+	fields := map[string]string{
+		"bank_id":        attributes.BankID,
+		"account_number": attributes.AccountNumber,
+		"iban":           attributes.Iban,
+		"country":        country,
+		"customer_id":    attributes.CustomerID,
+	}
+
+	// Unknown filters are ignored, like the API does
+	for field, accepted := range filters {
+		if value, known := fields[field]; known && !containsValue(accepted, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func pageLink(query url.Values, pageNumber int, pageSize int) *string {
+	linkQuery := url.Values{}
+	for param, values := range query {
+		if strings.HasPrefix(param, "filter[") {
+			linkQuery[param] = values
+		}
+	}
+	linkQuery.Set("page[number]", strconv.Itoa(pageNumber))
+	linkQuery.Set("page[size]", strconv.Itoa(pageSize))
+
+	link := accountsPath + "?" + linkQuery.Encode()
+	return &link
+}
+
+// Applies the fields set in the patch to the account.
+func applyPatch(account *models.AccountData, patch *models.AccountPatchAttributes) {
+	if patch == nil {
+		return
+	}
+	if account.Attributes == nil {
+		account.Attributes = &models.AccountAttributes{}
+	}
+
+	attributes := account.Attributes
+	setString := func(target *string, value *string) {
+		if value != nil {
+			*target = *value
+		}
+	}
+
+	if patch.AccountClassification != nil {
+		attributes.AccountClassification = patch.AccountClassification
+	}
+	if patch.AccountMatchingOptOut != nil {
+		attributes.AccountMatchingOptOut = patch.AccountMatchingOptOut
+	}
+	setString(&attributes.AccountNumber, patch.AccountNumber)
+	if patch.AlternativeNames != nil {
+		attributes.AlternativeNames = *patch.AlternativeNames
+	}
+	setString(&attributes.BankID, patch.BankID)
+	setString(&attributes.BankIDCode, patch.BankIDCode)
+	setString(&attributes.CustomerID, patch.CustomerID)
+	setString(&attributes.BaseCurrency, patch.BaseCurrency)
+	setString(&attributes.Bic, patch.Bic)
+	if patch.Country != nil {
+		attributes.Country = patch.Country
+	}
+	setString(&attributes.Iban, patch.Iban)
+	if patch.JointAccount != nil {
+		attributes.JointAccount = patch.JointAccount
+	}
+	if patch.Name != nil {
+		attributes.Name = *patch.Name
+	}
+	setString(&attributes.SecondaryIdentification, patch.SecondaryIdentification)
+	if patch.Status != nil {
+		attributes.Status = patch.Status
+	}
+	if patch.Switched != nil {
+		attributes.Switched = patch.Switched
+	}
+}
@@ -0,0 +1,247 @@
+package fakeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	client "github.com/danimagb/api-client/pkg"
+	"github.com/danimagb/api-client/pkg/accounts"
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, handler *Handler) *client.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	u, _ := url.Parse(server.URL)
+
+	sut, err := client.NewClient(
+		client.WithBaseUrl(*u),
+		client.WithHttpClient(server.Client()),
+		client.WithTimeoutInMilliseconds(1000),
+	)
+	if err != nil {
+		t.Fatalf("Error creating client: %v", err)
+	}
+
+	return sut
+}
+
+func newAccount(country string, bankID string) *models.AccountRequest {
+	return &models.AccountRequest{
+		Data: &models.AccountData{
+			Attributes: &models.AccountAttributes{
+				BankID:  bankID,
+				Country: &country,
+				Name:    []string{"Daniel"},
+			},
+			ID:             uuid.NewString(),
+			OrganisationID: uuid.NewString(),
+			Type:           "accounts",
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Given a valid account should create it with version 0", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		// Act
+		actual, err := sut.Accounts.Create(context.Background(), newAccount("GB", "400300"))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), *actual.Data.Version)
+		assert.Equal(t, "/v1/organisation/accounts/"+actual.Data.ID, *actual.Links.Self)
+	})
+
+	t.Run("Given an invalid account should return a bad request with the validation failures", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		account := newAccount("gb", "400300")
+		account.Data.Attributes.Name = nil
+
+		// Act
+		actual, err := sut.Accounts.Create(context.Background(), account)
+
+		// Assert
+		assert.Nil(t, actual)
+
+		var badRequestError *core.BadRequestError
+		assert.True(t, errors.As(err, &badRequestError))
+		assert.ElementsMatch(t, []string{"country in body should match '^[A-Z]{2}$'", "name in body is required"}, badRequestError.Details)
+	})
+
+	t.Run("Given an account with an existing id should return a conflict", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		account := newAccount("GB", "400300")
+		sut.Accounts.Create(context.Background(), account)
+
+		// Act
+		actual, err := sut.Accounts.Create(context.Background(), account)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+	})
+}
+
+func TestFetch(t *testing.T) {
+	t.Run("Given an existent account should return it", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+		expected, _ := sut.Accounts.Create(context.Background(), newAccount("GB", "400300"))
+
+		// Act
+		actual, err := sut.Accounts.Fetch(context.Background(), uuid.MustParse(expected.Data.ID))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Given a non existent account should return not found", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		// Act
+		actual, err := sut.Accounts.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("Given accounts across several pages should iterate through all of them", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		var expected []string
+		for i := 0; i < 5; i++ {
+			created, _ := sut.Accounts.Create(context.Background(), newAccount("GB", "400300"))
+			expected = append(expected, created.Data.ID)
+		}
+
+		// Act
+		var actual []string
+		it := sut.Accounts.ListIterator(&accounts.ListOptions{PageOptions: core.PageOptions{PageSize: 2}})
+		for it.Next(context.Background()) {
+			for _, account := range it.Page().Data {
+				actual = append(actual, account.ID)
+			}
+		}
+
+		// Assert
+		assert.Nil(t, it.Err())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Given filters should only return the matching accounts", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		sut.Accounts.Create(context.Background(), newAccount("GB", "400300"))
+		expected, _ := sut.Accounts.Create(context.Background(), newAccount("FR", "400301"))
+		sut.Accounts.Create(context.Background(), newAccount("FR", "400302"))
+
+		// Act
+		actual, err := sut.Accounts.List(context.Background(), &accounts.ListOptions{
+			Filter: accounts.ListFilter{Country: []string{"FR"}, BankID: []string{"400301"}},
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, actual.Data, 1)
+		assert.Equal(t, expected.Data.ID, actual.Data[0].ID)
+		assert.Nil(t, actual.Links.Next)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("Given the current version should apply the patch and increment the version", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+		created, _ := sut.Accounts.Create(context.Background(), newAccount("GB", "400300"))
+
+		// Act
+		actual, err := sut.Accounts.Update(context.Background(), uuid.MustParse(created.Data.ID), 0, &models.AccountPatchAttributes{
+			BankID: models.String("400399"),
+		})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), *actual.Data.Version)
+		assert.Equal(t, "400399", actual.Data.Attributes.BankID)
+		assert.Equal(t, []string{"Daniel"}, actual.Data.Attributes.Name)
+	})
+
+	t.Run("Given an outdated version should return a conflict", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+		created, _ := sut.Accounts.Create(context.Background(), newAccount("GB", "400300"))
+
+		// Act
+		actual, err := sut.Accounts.Update(context.Background(), uuid.MustParse(created.Data.ID), 5, &models.AccountPatchAttributes{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("Given the current version should delete the account", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+		created, _ := sut.Accounts.Create(context.Background(), newAccount("GB", "400300"))
+		id := uuid.MustParse(created.Data.ID)
+
+		// Act
+		err := sut.Accounts.Delete(context.Background(), id, 0)
+
+		// Assert
+		assert.Nil(t, err)
+
+		_, fetchErr := sut.Accounts.Fetch(context.Background(), id)
+		assert.True(t, errors.Is(fetchErr, core.ErrNotFound))
+	})
+
+	t.Run("Given an outdated version should return a conflict", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+		created, _ := sut.Accounts.Create(context.Background(), newAccount("GB", "400300"))
+
+		// Act
+		err := sut.Accounts.Delete(context.Background(), uuid.MustParse(created.Data.ID), 3)
+
+		// Assert
+		assert.True(t, errors.Is(err, core.ErrConflict))
+	})
+
+	t.Run("Given a non existent account should return not found", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		id := uuid.New()
+
+		// Act
+		err := sut.Accounts.Delete(context.Background(), id, 0)
+
+		// Assert
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+		assert.Contains(t, err.Error(), fmt.Sprintf("record %s does not exist", id))
+	})
+}
@@ -0,0 +1,48 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/confirmationofpayee"
+	"github.com/danimagb/api-client/pkg/models"
+)
+
+// Checks the name against the stored account with the same bank id and account number, with the matching
+// rules of confirmationofpayee.CheckName. The name check itself is not stored.
+func (h *Handler) checkName(w http.ResponseWriter, r *http.Request) {
+	request := &models.ConfirmationOfPayeeRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	if request.Data == nil || request.Data.Attributes == nil {
+		writeError(w, http.StatusBadRequest, "validation failure list:\nvalidation failure list:\nattributes in body is required")
+		return
+	}
+
+	attributes := request.Data.Attributes
+	if attributes.AccountNumber == "" || attributes.BankID == "" || attributes.Name == "" {
+		writeError(w, http.StatusBadRequest, "validation failure list:\nvalidation failure list:\naccount_number, bank_id and name in body are required")
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var account *models.AccountAttributes
+	for _, id := range h.order {
+		stored := h.accounts[id].Attributes
+		if stored != nil && stored.BankID == attributes.BankID && stored.AccountNumber == attributes.AccountNumber {
+			account = stored
+			break
+		}
+	}
+
+	response := &models.ConfirmationOfPayeeResponse{Data: request.Data}
+	response.Data.Attributes.Result = models.NewConfirmationOfPayeeResult(confirmationofpayee.CheckName(account, attributes.Name, attributes.AccountType))
+
+	writeJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,63 @@
+package fakeapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/confirmationofpayee"
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckName(t *testing.T) {
+	t.Run("Given a close match on a stored account should return the account name as suggestion", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		account := newAccount("GB", "400300")
+		account.Data.Attributes.AccountNumber = "41426819"
+		account.Data.Attributes.Name = []string{"John", "Smith"}
+		sut.Accounts.Create(context.Background(), account)
+
+		nameCheck, _ := confirmationofpayee.NewNameCheckRequest(account.Data.OrganisationID, account.Data.Attributes, "Jon Smith")
+
+		// Act
+		actual, err := sut.ConfirmationOfPayee.Check(context.Background(), nameCheck)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, models.NameCheckCloseMatch, actual.Outcome)
+		assert.Equal(t, "John Smith", actual.SuggestedName)
+	})
+
+	t.Run("Given an unknown account should return no match", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		nameCheck, _ := confirmationofpayee.NewNameCheckRequest("", &models.AccountAttributes{AccountNumber: "41426819", BankID: "400300"}, "John Smith")
+
+		// Act
+		actual, err := sut.ConfirmationOfPayee.Check(context.Background(), nameCheck)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, models.NameCheckNoMatch, actual.Outcome)
+		assert.Equal(t, models.NameCheckAccountDoesNotExistCode, actual.ReasonCode)
+	})
+
+	t.Run("Given a name check without name should return a bad request", func(t *testing.T) {
+		// Arrange
+		sut := newTestClient(t, NewHandler())
+
+		nameCheck, _ := confirmationofpayee.NewNameCheckRequest("", &models.AccountAttributes{AccountNumber: "41426819", BankID: "400300"}, "")
+
+		// Act
+		actual, err := sut.ConfirmationOfPayee.Check(context.Background(), nameCheck)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
+	})
+}
@@ -0,0 +1,110 @@
+// Package fakeapi provides an in-memory implementation of the Account API, meant to be served
+// with httptest.NewServer so that tests can run without the docker-compose environment.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/danimagb/api-client/pkg/models"
+)
+
+const (
+	healthPath     string = "/v1/health"
+	accountsPath   string = "/v1/organisation/accounts"
+	nameChecksPath string = "/v1/confirmation-of-payee/name-checks"
+)
+
+// Handler serves the accounts endpoints (create, fetch, list, update and delete), the Confirmation of Payee
+// name checks against the stored accounts and the health endpoint.
+// It is safe for concurrent use.
+type Handler struct {
+	mu       sync.Mutex
+	accounts map[string]*models.AccountData
+	// ids in creation order, so that listings are stable
+	order []string
+}
+
+// NewHandler returns a Handler without any account.
+func NewHandler() *Handler {
+	return &Handler{
+		accounts: map[string]*models.AccountData{},
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch {
+	case path == healthPath && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]string{"status": "up"})
+	case path == accountsPath && r.Method == http.MethodPost:
+		h.create(w, r)
+	case path == accountsPath && r.Method == http.MethodGet:
+		h.list(w, r)
+	case path == nameChecksPath && r.Method == http.MethodPost:
+		h.checkName(w, r)
+	case strings.HasPrefix(path, accountsPath+"/"):
+		id := strings.TrimPrefix(path, accountsPath+"/")
+		switch r.Method {
+		case http.MethodGet:
+			h.fetch(w, id)
+		case http.MethodPatch:
+			h.update(w, r, id)
+		case http.MethodDelete:
+			h.delete(w, r, id)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s is not allowed", r.Method))
+		}
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("route %s %s not found", r.Method, r.URL.Path))
+	}
+}
+
+// SetStatus changes the status of an existing account, as the platform would when processing it.
+// It returns false when the account does not exist.
+func (h *Handler) SetStatus(id string, status string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	account, ok := h.accounts[id]
+	if !ok {
+		return false
+	}
+
+	if account.Attributes == nil {
+		account.Attributes = &models.AccountAttributes{}
+	}
+	account.Attributes.Status = &status
+	*account.Version++
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, &models.APIError{ErrorMessage: message})
+}
+
+func selfLink(id string) *models.Links {
+	self := accountsPath + "/" + id
+	return &models.Links{Self: &self}
+}
+
+// Returns a deep copy of the account, so that callers cannot change the stored one.
+func copyAccount(account *models.AccountData) *models.AccountData {
+	var clone models.AccountData
+
+	raw, _ := json.Marshal(account)
+	json.Unmarshal(raw, &clone)
+
+	return &clone
+}
@@ -0,0 +1,61 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeHTTP(t *testing.T) {
+	t.Run("Given a health request should report the service as up", func(t *testing.T) {
+		// Arrange
+		recorder := httptest.NewRecorder()
+
+		// Act
+		NewHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/health", nil))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"status":"up"}`, recorder.Body.String())
+	})
+
+	t.Run("Given an unknown route should return 404 with an api error", func(t *testing.T) {
+		// Arrange
+		recorder := httptest.NewRecorder()
+
+		// Act
+		NewHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/unknown", nil))
+
+		// Assert
+		apiError := &models.APIError{}
+		json.Unmarshal(recorder.Body.Bytes(), apiError)
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.NotEmpty(t, apiError.ErrorMessage)
+	})
+
+	t.Run("Given an unsupported method on an account should return 405", func(t *testing.T) {
+		// Arrange
+		recorder := httptest.NewRecorder()
+
+		// Act
+		NewHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, "/v1/organisation/accounts/ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", nil))
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	})
+}
+
+func TestSetStatus(t *testing.T) {
+	t.Run("Given a non existent account should return false", func(t *testing.T) {
+		// Act
+		actual := NewHandler().SetStatus("ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", "confirmed")
+
+		// Assert
+		assert.False(t, actual)
+	})
+}
@@ -0,0 +1,79 @@
+package fakeapi
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+var (
+	countryPattern  = regexp.MustCompile(`^[A-Z]{2}$`)
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	bicPattern      = regexp.MustCompile(`^([A-Z]{6}[A-Z0-9]{2}|[A-Z]{6}[A-Z0-9]{5})$`)
+)
+
+// Validates the account the same way the API does, returning one message per failure.
+func validateAccount(data *models.AccountData) []string {
+	if data == nil {
+		return []string{"data in body is required"}
+	}
+
+	var failures []string
+
+	if data.ID == "" {
+		failures = append(failures, "id in body is required")
+	} else if _, err := uuid.Parse(data.ID); err != nil {
+		failures = append(failures, fmt.Sprintf("id in body must be of type uuid: \"%s\"", data.ID))
+	}
+
+	if data.OrganisationID == "" {
+		failures = append(failures, "organisation_id in body is required")
+	} else if _, err := uuid.Parse(data.OrganisationID); err != nil {
+		failures = append(failures, fmt.Sprintf("organisation_id in body must be of type uuid: \"%s\"", data.OrganisationID))
+	}
+
+	if data.Type != "accounts" {
+		failures = append(failures, "type in body should be one of [accounts]")
+	}
+
+	attributes := data.Attributes
+	if attributes == nil {
+		return append(failures, "attributes in body is required")
+	}
+
+	if attributes.Country == nil {
+		failures = append(failures, "country in body is required")
+	} else if !countryPattern.MatchString(*attributes.Country) {
+		failures = append(failures, "country in body should match '^[A-Z]{2}$'")
+	}
+
+	if len(attributes.Name) == 0 {
+		failures = append(failures, "name in body is required")
+	} else if len(attributes.Name) > 4 {
+		failures = append(failures, "name in body should have at most 4 items")
+	}
+
+	if len(attributes.AlternativeNames) > 3 {
+		failures = append(failures, "alternative_names in body should have at most 3 items")
+	}
+
+	if attributes.AccountClassification != nil && *attributes.AccountClassification != "Personal" && *attributes.AccountClassification != "Business" {
+		failures = append(failures, "account_classification in body should be one of [Personal Business]")
+	}
+
+	if attributes.BaseCurrency != "" && !currencyPattern.MatchString(attributes.BaseCurrency) {
+		failures = append(failures, "base_currency in body should match '^[A-Z]{3}$'")
+	}
+
+	if attributes.Bic != "" && !bicPattern.MatchString(attributes.Bic) {
+		failures = append(failures, "bic in body should match '^([A-Z]{6}[A-Z0-9]{2}|[A-Z]{6}[A-Z0-9]{5})$'")
+	}
+
+	if attributes.Status != nil && *attributes.Status != "pending" && *attributes.Status != "confirmed" && *attributes.Status != "failed" && *attributes.Status != "closed" {
+		failures = append(failures, "status in body should be one of [pending confirmed failed closed]")
+	}
+
+	return failures
+}
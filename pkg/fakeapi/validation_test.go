@@ -0,0 +1,60 @@
+package fakeapi
+
+import (
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAccount(t *testing.T) {
+	t.Run("Given a valid account should not return failures", func(t *testing.T) {
+		// Arrange
+		account := newAccount("GB", "400300").Data
+		account.Attributes.Bic = "NWBKGB22"
+		account.Attributes.BaseCurrency = "GBP"
+
+		// Act
+		actual := validateAccount(account)
+
+		// Assert
+		assert.Empty(t, actual)
+	})
+
+	t.Run("Given no data should return a single failure", func(t *testing.T) {
+		// Act
+		actual := validateAccount(nil)
+
+		// Assert
+		assert.Equal(t, []string{"data in body is required"}, actual)
+	})
+
+	t.Run("Given invalid fields should return a failure for each of them", func(t *testing.T) {
+		// Arrange
+		classification := "Other"
+		account := &models.AccountData{
+			ID:             "not-a-uuid",
+			OrganisationID: "",
+			Type:           "payments",
+			Attributes: &models.AccountAttributes{
+				AccountClassification: &classification,
+				Bic:                   "invalid",
+				Name:                  []string{"a", "b", "c", "d", "e"},
+			},
+		}
+
+		// Act
+		actual := validateAccount(account)
+
+		// Assert
+		assert.Equal(t, []string{
+			"id in body must be of type uuid: \"not-a-uuid\"",
+			"organisation_id in body is required",
+			"type in body should be one of [accounts]",
+			"country in body is required",
+			"name in body should have at most 4 items",
+			"account_classification in body should be one of [Personal Business]",
+			"bic in body should match '^([A-Z]{6}[A-Z0-9]{2}|[A-Z]{6}[A-Z0-9]{5})$'",
+		}, actual)
+	})
+}
@@ -0,0 +1,46 @@
+// Package coretest provides the test doubles of the core package shared by the tests of the resource clients.
+package coretest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockedBaseClient is a core.Client whose responses are set up with testify mock expectations on Send.
+type MockedBaseClient struct {
+	mock.Mock
+}
+
+func (m *MockedBaseClient) Send(req *core.Request) (*core.Response, error) {
+
+	args := m.Called(req)
+
+	if args.Get(0) != nil {
+		return args.Get(0).(*core.Response), args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
+// SentRequest returns the request sent in the call-th call to Send, starting at 0.
+func (m *MockedBaseClient) SentRequest(call int) *core.Request {
+	return m.Calls[call].Arguments.Get(0).(*core.Request)
+}
+
+// NewResponse returns a response with the given status code and an empty body.
+func NewResponse(statusCode int) *core.Response {
+	httpResponse := &http.Response{StatusCode: statusCode, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}
+
+	return &core.Response{
+		RawResponse: httpResponse,
+	}
+}
+
+// IsMethod matches the requests sent with the given http method.
+func IsMethod(method string) interface{} {
+	return mock.MatchedBy(func(req *core.Request) bool { return req.Method == method })
+}
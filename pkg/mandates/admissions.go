@@ -0,0 +1,76 @@
+package mandates
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+// CreateAdmission records the admission of a mandate received from the scheme.
+func (mc *MandatesClient) CreateAdmission(ctx context.Context, mandateID uuid.UUID, admission *models.MandateAdmissionRequest) (*models.MandateAdmissionResponse, error) {
+	admissionResponse := &models.MandateAdmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseMandatesPath).
+		WithPath(mandateID.String()).
+		WithPath(admissionsPath).
+		WithBody(admission)
+
+	if err := core.CreateRecord(ctx, mc.baseClient, builder, admissionResponse); err != nil {
+		return nil, err
+	}
+
+	return admissionResponse, nil
+}
+
+func (mc *MandatesClient) FetchAdmission(ctx context.Context, mandateID uuid.UUID, admissionID uuid.UUID) (*models.MandateAdmissionResponse, error) {
+	admissionResponse := &models.MandateAdmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseMandatesPath).
+		WithPath(mandateID.String()).
+		WithPath(admissionsPath).
+		WithPath(admissionID.String())
+
+	if err := core.SendExpecting(ctx, mc.baseClient, builder, http.StatusOK, admissionResponse); err != nil {
+		return nil, err
+	}
+
+	return admissionResponse, nil
+}
+
+// CreateSubmission submits the mandate to the scheme. The submission status is then followed with FetchSubmission.
+func (mc *MandatesClient) CreateSubmission(ctx context.Context, mandateID uuid.UUID, submission *models.MandateSubmissionRequest) (*models.MandateSubmissionResponse, error) {
+	submissionResponse := &models.MandateSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseMandatesPath).
+		WithPath(mandateID.String()).
+		WithPath(submissionsPath).
+		WithBody(submission)
+
+	if err := core.CreateRecord(ctx, mc.baseClient, builder, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
+
+func (mc *MandatesClient) FetchSubmission(ctx context.Context, mandateID uuid.UUID, submissionID uuid.UUID) (*models.MandateSubmissionResponse, error) {
+	submissionResponse := &models.MandateSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseMandatesPath).
+		WithPath(mandateID.String()).
+		WithPath(submissionsPath).
+		WithPath(submissionID.String())
+
+	if err := core.SendExpecting(ctx, mc.baseClient, builder, http.StatusOK, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
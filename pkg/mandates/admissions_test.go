@@ -0,0 +1,108 @@
+package mandates
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAdmissions(t *testing.T) {
+	mandateID, admissionID := uuid.New(), uuid.New()
+
+	t.Run("Given an admission should send it to the mandate admissions", func(t *testing.T) {
+		// Arrange
+		admission := &models.MandateAdmissionRequest{Data: &models.MandateAdmissionData{ID: admissionID.String(), Type: "mandate_admissions"}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.CreateAdmission(context.Background(), mandateID, admission)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, baseMandatesPath+"/"+mandateID.String()+"/admissions", apiReq.Path)
+		assert.Equal(t, admission, apiReq.Body)
+	})
+
+	t.Run("Given an admission id should fetch it from the mandate admissions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.FetchAdmission(context.Background(), mandateID, admissionID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, baseMandatesPath+"/"+mandateID.String()+"/admissions/"+admissionID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+}
+
+func TestSubmissions(t *testing.T) {
+	mandateID, submissionID := uuid.New(), uuid.New()
+
+	t.Run("Given a submission should send it to the mandate submissions with an idempotency key", func(t *testing.T) {
+		// Arrange
+		submission := &models.MandateSubmissionRequest{Data: &models.MandateSubmissionData{ID: submissionID.String(), Type: "mandate_submissions"}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.CreateSubmission(context.Background(), mandateID, submission)
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, baseMandatesPath+"/"+mandateID.String()+"/submissions", apiReq.Path)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a submission id should fetch it from the mandate submissions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.FetchSubmission(context.Background(), mandateID, submissionID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, baseMandatesPath+"/"+mandateID.String()+"/submissions/"+submissionID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code other than 200 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(404), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.FetchSubmission(context.Background(), mandateID, submissionID)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
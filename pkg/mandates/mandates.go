@@ -0,0 +1,113 @@
+// Package mandates provides the client of the direct debit mandates API: mandates, their cancellations,
+// their submissions to the scheme and the admissions of mandates received from the scheme.
+package mandates
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	baseMandatesPath  string = "/v1/transaction/mandates"
+	cancellationsPath string = "cancellations"
+	admissionsPath    string = "admissions"
+	submissionsPath   string = "submissions"
+)
+
+type MandatesClient struct {
+	baseClient core.Client
+}
+
+func New(baseClient core.Client) *MandatesClient {
+	return &MandatesClient{
+		baseClient: baseClient,
+	}
+}
+
+func (mc *MandatesClient) Fetch(ctx context.Context, id uuid.UUID) (*models.MandateResponse, error) {
+	mandateResponse := &models.MandateResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseMandatesPath).
+		WithPath(id.String())
+
+	if err := core.SendExpecting(ctx, mc.baseClient, builder, http.StatusOK, mandateResponse); err != nil {
+		return nil, err
+	}
+
+	return mandateResponse, nil
+}
+
+func (mc *MandatesClient) Create(ctx context.Context, mandate *models.MandateRequest) (*models.MandateResponse, error) {
+	mandateResponse := &models.MandateResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseMandatesPath).
+		WithBody(mandate)
+
+	if err := core.CreateRecord(ctx, mc.baseClient, builder, mandateResponse); err != nil {
+		return nil, err
+	}
+
+	return mandateResponse, nil
+}
+
+// ListOptions holds the paging and filtering parameters used when listing mandates.
+type ListOptions struct {
+	core.PageOptions
+	Filter ListFilter
+}
+
+// ListFilter restricts the mandates returned by List. Every field accepts multiple values.
+type ListFilter struct {
+	Status              []string
+	Reference           []string
+	DebtorAccountNumber []string
+}
+
+// List returns a single page of mandates together with the links to the surrounding pages.
+func (mc *MandatesClient) List(ctx context.Context, opts *ListOptions) (*models.MandateListResponse, error) {
+	listResponse := &models.MandateListResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseMandatesPath)
+
+	if opts != nil {
+		builder = opts.apply(builder)
+	}
+
+	if err := core.SendExpecting(ctx, mc.baseClient, builder, http.StatusOK, listResponse); err != nil {
+		return nil, err
+	}
+
+	return listResponse, nil
+}
+
+// Cancel cancels the mandate, so that no more direct debits are collected with it.
+func (mc *MandatesClient) Cancel(ctx context.Context, id uuid.UUID, cancellation *models.MandateCancellationRequest) (*models.MandateCancellationResponse, error) {
+	cancellationResponse := &models.MandateCancellationResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseMandatesPath).
+		WithPath(id.String()).
+		WithPath(cancellationsPath).
+		WithBody(cancellation)
+
+	if err := core.CreateRecord(ctx, mc.baseClient, builder, cancellationResponse); err != nil {
+		return nil, err
+	}
+
+	return cancellationResponse, nil
+}
+
+func (opts *ListOptions) apply(builder core.RequestBuilder) core.RequestBuilder {
+	return core.ApplyList(builder, opts.PageOptions, core.ListFilters{
+		"filter[status]":                      opts.Filter.Status,
+		"filter[reference]":                   opts.Filter.Reference,
+		"filter[debtor_party.account_number]": opts.Filter.DebtorAccountNumber,
+	})
+}
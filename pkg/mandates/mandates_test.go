@@ -0,0 +1,242 @@
+package mandates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetch(t *testing.T) {
+	t.Run("Given an error calling base client should return an error", func(t *testing.T) {
+		// Arrange
+		expectedError := fmt.Errorf("Some error occurred")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given a response with status code 200 should return the mandate", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), id)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, http.MethodGet, mockedBaseClient.SentRequest(0).Method)
+		assert.Equal(t, baseMandatesPath+"/"+id.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code 404 should return a not found error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(404), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Given a mandate should send it with an idempotency key", func(t *testing.T) {
+		// Arrange
+		mandate := &models.MandateRequest{Data: &models.MandateData{
+			ID:         uuid.NewString(),
+			Type:       "mandates",
+			Attributes: &models.MandateAttributes{PaymentScheme: models.SchemeBacs, Reference: "REF-123"},
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), mandate)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, baseMandatesPath, apiReq.Path)
+		assert.Equal(t, mandate, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the mandate with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.Create(ctx, &models.MandateRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+mockedBaseClient.SentRequest(0).Path, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a duplicate conflict after a retried attempt should return the mandate created by the earlier attempt", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		organisationID := uuid.NewString()
+		mandate := &models.MandateRequest{Data: &models.MandateData{ID: id.String(), OrganisationID: organisationID}}
+
+		conflict := coretest.NewResponse(409)
+		conflict.Attempts = 2
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.MatchedBy(func(req *core.Request) bool { return req.Method == http.MethodPost })).Return(conflict, nil)
+		mockedBaseClient.On("Send", mock.MatchedBy(func(req *core.Request) bool { return req.Method == http.MethodGet })).Return(coretest.NewResponse(200), nil).
+			Run(func(args mock.Arguments) {
+				result := args.Get(0).(*core.Request).Result.(*models.MandateResponse)
+				result.Data = &models.MandateData{ID: id.String(), OrganisationID: organisationID}
+			})
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), mandate)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, id.String(), actual.Data.ID)
+		assert.Equal(t, baseMandatesPath+"/"+id.String(), mockedBaseClient.SentRequest(1).Path)
+	})
+
+	t.Run("Given a response with status code other than 201 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(400), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), &models.MandateRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("Given paging and filters should send them as query parameters", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		opts := &ListOptions{
+			PageOptions: core.PageOptions{PageSize: 20},
+			Filter:      ListFilter{Status: []string{"pending", "confirmed"}, Reference: []string{"REF-123"}},
+		}
+
+		// Act
+		actual, err := sut.List(context.Background(), opts)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, baseMandatesPath, apiReq.Path)
+		assert.Equal(t, "20", apiReq.QueryParam.Get("page[size]"))
+		assert.Equal(t, []string{"pending", "confirmed"}, apiReq.QueryParam["filter[status]"])
+		assert.Equal(t, []string{"REF-123"}, apiReq.QueryParam["filter[reference]"])
+		assert.NotContains(t, apiReq.QueryParam, "page[number]")
+	})
+
+	t.Run("Given a response with status code other than 200 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(500), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.List(context.Background(), nil)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrServerError))
+	})
+}
+
+func TestCancel(t *testing.T) {
+	t.Run("Given a cancellation should send it to the mandate cancellations", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		cancellation := &models.MandateCancellationRequest{Data: &models.MandateCancellationData{
+			ID:         uuid.NewString(),
+			Type:       "mandate_cancellations",
+			Attributes: &models.MandateCancellationAttributes{Reason: "Customer request"},
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Cancel(context.Background(), id, cancellation)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, baseMandatesPath+"/"+id.String()+"/cancellations", apiReq.Path)
+		assert.Equal(t, cancellation, apiReq.Body)
+	})
+
+	t.Run("Given a response with status code 409 should return a conflict error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(409), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Cancel(context.Background(), uuid.New(), &models.MandateCancellationRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+	})
+}
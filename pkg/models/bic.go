@@ -0,0 +1,58 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidBIC = errors.New("invalid BIC")
+
+var bicFormat = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// BIC is a validated Business Identifier Code (SWIFT code).
+type BIC struct {
+	value string
+}
+
+// ParseBIC validates the 8 or 11 character structure of the BIC: bank code, country code,
+// location code and optional branch code.
+func ParseBIC(value string) (BIC, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+
+	if !bicFormat.MatchString(normalized) {
+		return BIC{}, fmt.Errorf("%w: '%s' must have 8 or 11 characters in the AAAABBCC(DDD) format", ErrInvalidBIC, value)
+	}
+
+	return BIC{value: normalized}, nil
+}
+
+func (b BIC) String() string {
+	return b.value
+}
+
+func (b BIC) BankCode() string {
+	return b.value[:4]
+}
+
+func (b BIC) CountryCode() string {
+	return b.value[4:6]
+}
+
+func (b BIC) LocationCode() string {
+	return b.value[6:8]
+}
+
+// BranchCode returns the branch code, XXX for the primary office when the BIC has 8 characters.
+func (b BIC) BranchCode() string {
+	if len(b.value) == 8 {
+		return "XXX"
+	}
+	return b.value[8:]
+}
+
+// IsPrimaryOffice reports whether the BIC identifies the primary office of the bank.
+func (b BIC) IsPrimaryOffice() bool {
+	return b.BranchCode() == "XXX"
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBIC(t *testing.T) {
+	t.Run("Given an 8 character BIC should parse it as the primary office", func(t *testing.T) {
+		// Act
+		bic, err := ParseBIC("nwbkgb2l")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "NWBKGB2L", bic.String())
+		assert.Equal(t, "NWBK", bic.BankCode())
+		assert.Equal(t, "GB", bic.CountryCode())
+		assert.Equal(t, "2L", bic.LocationCode())
+		assert.Equal(t, "XXX", bic.BranchCode())
+		assert.True(t, bic.IsPrimaryOffice())
+	})
+
+	t.Run("Given an 11 character BIC should parse its branch code", func(t *testing.T) {
+		// Act
+		bic, err := ParseBIC("DEUTDEFF500")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "500", bic.BranchCode())
+		assert.False(t, bic.IsPrimaryOffice())
+	})
+
+	for _, value := range []string{"NWBKGB2", "NWBKGB2L50", "NWBK122L", "NWBKGB2L5000", ""} {
+		t.Run("Given a BIC with an invalid structure should return an error", func(t *testing.T) {
+			// Act
+			_, err := ParseBIC(value)
+
+			// Assert
+			assert.True(t, errors.Is(err, ErrInvalidBIC), value)
+		})
+	}
+}
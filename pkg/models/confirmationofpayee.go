@@ -0,0 +1,141 @@
+package models
+
+// Account types checked by a Confirmation of Payee request, matching the AccountClassification of the accounts.
+const (
+	AccountTypePersonal string = "Personal"
+	AccountTypeBusiness string = "Business"
+)
+
+// NameCheckReasonCode is the Confirmation of Payee code explaining why a name check did not fully match.
+type NameCheckReasonCode string
+
+const (
+	NameCheckNoMatchCode                    NameCheckReasonCode = "ANNM"
+	NameCheckCloseMatchCode                 NameCheckReasonCode = "MBAM"
+	NameCheckBusinessAccountCode            NameCheckReasonCode = "BANM"
+	NameCheckPersonalAccountCode            NameCheckReasonCode = "PANM"
+	NameCheckBusinessAccountCloseMatchCode  NameCheckReasonCode = "BAMM"
+	NameCheckPersonalAccountCloseMatchCode  NameCheckReasonCode = "PAMM"
+	NameCheckAccountDoesNotExistCode        NameCheckReasonCode = "AC01"
+	NameCheckOptedOutCode                   NameCheckReasonCode = "OPTO"
+	NameCheckAccountNotSupportedCode        NameCheckReasonCode = "ACNS"
+	NameCheckSecondaryReferenceRequiredCode NameCheckReasonCode = "SCNS"
+)
+
+var nameCheckReasonDescriptions = map[NameCheckReasonCode]string{
+	NameCheckNoMatchCode:                    "Account name does not match",
+	NameCheckCloseMatchCode:                 "Account name is a close match",
+	NameCheckBusinessAccountCode:            "Account name matches but the account is a business account",
+	NameCheckPersonalAccountCode:            "Account name matches but the account is a personal account",
+	NameCheckBusinessAccountCloseMatchCode:  "Account name is a close match and the account is a business account",
+	NameCheckPersonalAccountCloseMatchCode:  "Account name is a close match and the account is a personal account",
+	NameCheckAccountDoesNotExistCode:        "Account does not exist",
+	NameCheckOptedOutCode:                   "Account holder opted out of Confirmation of Payee",
+	NameCheckAccountNotSupportedCode:        "Account not supported by Confirmation of Payee",
+	NameCheckSecondaryReferenceRequiredCode: "Secondary reference required",
+}
+
+// Description returns a human readable description of the code, or an empty string when the code is unknown.
+func (c NameCheckReasonCode) Description() string {
+	return nameCheckReasonDescriptions[c]
+}
+
+// IsKnown reports whether the code is one of the codes declared in this package.
+func (c NameCheckReasonCode) IsKnown() bool {
+	_, ok := nameCheckReasonDescriptions[c]
+	return ok
+}
+
+// NameCheckOutcome summarises the result of a name check, which drives what the payer is shown.
+type NameCheckOutcome string
+
+const (
+	NameCheckMatch               NameCheckOutcome = "match"
+	NameCheckCloseMatch          NameCheckOutcome = "close_match"
+	NameCheckNoMatch             NameCheckOutcome = "no_match"
+	NameCheckAccountTypeMismatch NameCheckOutcome = "account_type_mismatch"
+	NameCheckOptedOut            NameCheckOutcome = "opted_out"
+	// NameCheckUnavailable is returned when the name could not be checked, e.g. for an account not supported by the scheme.
+	NameCheckUnavailable NameCheckOutcome = "unavailable"
+)
+
+// NameCheckResult is the typed result of a name check. SuggestedName holds the actual account name
+// for close matches, so that the payer can be offered to use it.
+type NameCheckResult struct {
+	Outcome       NameCheckOutcome
+	ReasonCode    NameCheckReasonCode
+	SuggestedName string
+}
+
+// ConfirmationOfPayeeRequest checks the name of the payee against the account about to be paid.
+type ConfirmationOfPayeeRequest struct {
+	Data *ConfirmationOfPayeeData `json:"data,omitempty"`
+}
+
+type ConfirmationOfPayeeResponse struct {
+	Data  *ConfirmationOfPayeeData `json:"data,omitempty"`
+	Links *Links                   `json:"links,omitempty"`
+}
+
+type ConfirmationOfPayeeData struct {
+	Attributes     *ConfirmationOfPayeeAttributes `json:"attributes,omitempty"`
+	ID             string                         `json:"id,omitempty"`
+	OrganisationID string                         `json:"organisation_id,omitempty"`
+	Type           string                         `json:"type,omitempty"`
+	Version        *int64                         `json:"version,omitempty"`
+}
+
+type ConfirmationOfPayeeAttributes struct {
+	AccountNumber           string                     `json:"account_number,omitempty" redact:"last4"`
+	AccountType             string                     `json:"account_type,omitempty"`
+	BankID                  string                     `json:"bank_id,omitempty"`
+	BankIDCode              string                     `json:"bank_id_code,omitempty"`
+	Name                    string                     `json:"name,omitempty" redact:"full"`
+	SecondaryIdentification string                     `json:"secondary_identification,omitempty" redact:"full"`
+	Result                  *ConfirmationOfPayeeResult `json:"result,omitempty"`
+}
+
+// ConfirmationOfPayeeResult is the result of a name check as returned by the API. A full match has
+// Matched set and no reason code.
+type ConfirmationOfPayeeResult struct {
+	ActualName string              `json:"actual_name,omitempty" redact:"full"`
+	Matched    bool                `json:"matched"`
+	ReasonCode NameCheckReasonCode `json:"reason_code,omitempty"`
+}
+
+// NameCheckResult turns the result returned by the API into its typed outcome.
+func (r *ConfirmationOfPayeeResult) NameCheckResult() *NameCheckResult {
+	if r.Matched && r.ReasonCode == "" {
+		return &NameCheckResult{Outcome: NameCheckMatch}
+	}
+
+	result := &NameCheckResult{ReasonCode: r.ReasonCode}
+
+	switch r.ReasonCode {
+	case NameCheckCloseMatchCode:
+		result.Outcome = NameCheckCloseMatch
+		result.SuggestedName = r.ActualName
+	case NameCheckBusinessAccountCode, NameCheckPersonalAccountCode:
+		result.Outcome = NameCheckAccountTypeMismatch
+	case NameCheckBusinessAccountCloseMatchCode, NameCheckPersonalAccountCloseMatchCode:
+		result.Outcome = NameCheckAccountTypeMismatch
+		result.SuggestedName = r.ActualName
+	case NameCheckNoMatchCode, NameCheckAccountDoesNotExistCode:
+		result.Outcome = NameCheckNoMatch
+	case NameCheckOptedOutCode:
+		result.Outcome = NameCheckOptedOut
+	default:
+		result.Outcome = NameCheckUnavailable
+	}
+
+	return result
+}
+
+// NewConfirmationOfPayeeResult returns the API representation of a typed result, as a stand-in server would send it.
+func NewConfirmationOfPayeeResult(result *NameCheckResult) *ConfirmationOfPayeeResult {
+	return &ConfirmationOfPayeeResult{
+		ActualName: result.SuggestedName,
+		Matched:    result.Outcome == NameCheckMatch,
+		ReasonCode: result.ReasonCode,
+	}
+}
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameCheckResult(t *testing.T) {
+	testCases := []struct {
+		name     string
+		result   *ConfirmationOfPayeeResult
+		expected *NameCheckResult
+	}{
+		{
+			"Given a full match should return a match",
+			&ConfirmationOfPayeeResult{Matched: true},
+			&NameCheckResult{Outcome: NameCheckMatch},
+		},
+		{
+			"Given a close match should return the suggested name",
+			&ConfirmationOfPayeeResult{ReasonCode: NameCheckCloseMatchCode, ActualName: "John Smith"},
+			&NameCheckResult{Outcome: NameCheckCloseMatch, ReasonCode: NameCheckCloseMatchCode, SuggestedName: "John Smith"},
+		},
+		{
+			"Given a match on a business account should return an account type mismatch",
+			&ConfirmationOfPayeeResult{Matched: true, ReasonCode: NameCheckBusinessAccountCode},
+			&NameCheckResult{Outcome: NameCheckAccountTypeMismatch, ReasonCode: NameCheckBusinessAccountCode},
+		},
+		{
+			"Given a close match on a personal account should return an account type mismatch with the suggested name",
+			&ConfirmationOfPayeeResult{ReasonCode: NameCheckPersonalAccountCloseMatchCode, ActualName: "Jane Doe"},
+			&NameCheckResult{Outcome: NameCheckAccountTypeMismatch, ReasonCode: NameCheckPersonalAccountCloseMatchCode, SuggestedName: "Jane Doe"},
+		},
+		{
+			"Given an unknown account should return no match",
+			&ConfirmationOfPayeeResult{ReasonCode: NameCheckAccountDoesNotExistCode},
+			&NameCheckResult{Outcome: NameCheckNoMatch, ReasonCode: NameCheckAccountDoesNotExistCode},
+		},
+		{
+			"Given an opted out account should return opted out",
+			&ConfirmationOfPayeeResult{ReasonCode: NameCheckOptedOutCode},
+			&NameCheckResult{Outcome: NameCheckOptedOut, ReasonCode: NameCheckOptedOutCode},
+		},
+		{
+			"Given an unknown reason code should return unavailable",
+			&ConfirmationOfPayeeResult{ReasonCode: "IVCR"},
+			&NameCheckResult{Outcome: NameCheckUnavailable, ReasonCode: "IVCR"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			actual := tc.result.NameCheckResult()
+
+			// Assert
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("Given a typed result should round trip through its API representation", func(t *testing.T) {
+		// Arrange
+		expected := &NameCheckResult{Outcome: NameCheckCloseMatch, ReasonCode: NameCheckCloseMatchCode, SuggestedName: "John Smith"}
+
+		// Act
+		actual := NewConfirmationOfPayeeResult(expected).NameCheckResult()
+
+		// Assert
+		assert.Equal(t, expected, actual)
+		assert.True(t, NameCheckCloseMatchCode.IsKnown())
+		assert.NotEmpty(t, NameCheckCloseMatchCode.Description())
+	})
+}
+
+func TestConfirmationOfPayeeRedaction(t *testing.T) {
+	t.Run("Given a Confirmation of Payee response logged should redact the names and the account number", func(t *testing.T) {
+		// Arrange
+		body := `{"data":{"id":"ad27e265-9605-4b4b-a0e5-3003ea9cc4dc","attributes":{"account_number":"41426819","bank_id":"400300",` +
+			`"name":"Sam Holder","secondary_identification":"ROLL-123456","result":{"actual_name":"Samantha Holder","matched":false,"reason_code":"MBAM"}}}}`
+		result := &ConfirmationOfPayeeResponse{}
+
+		// Act
+		logs := logFetch(t, body, result)
+
+		// Assert
+		for _, value := range []string{"Sam Holder", "Samantha Holder", "41426819", "ROLL-123456"} {
+			assert.NotContains(t, logs, value)
+		}
+		assert.Contains(t, logs, `"account_number":"****6819"`)
+		assert.Contains(t, logs, `"reason_code":"MBAM"`)
+		assert.Equal(t, "Samantha Holder", result.Data.Attributes.Result.ActualName)
+	})
+}
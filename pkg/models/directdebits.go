@@ -0,0 +1,123 @@
+package models
+
+// Answers of a direct debit decision.
+const (
+	DirectDebitAccepted string = "accepted"
+	DirectDebitRejected string = "rejected"
+)
+
+type DirectDebitResponse struct {
+	Data  *DirectDebitData `json:"data,omitempty"`
+	Links *Links           `json:"links,omitempty"`
+}
+
+type DirectDebitListResponse struct {
+	Data  []*DirectDebitData `json:"data,omitempty"`
+	Links *Links             `json:"links,omitempty"`
+}
+
+type DirectDebitData struct {
+	Attributes     *DirectDebitAttributes    `json:"attributes,omitempty"`
+	ID             string                    `json:"id,omitempty"`
+	OrganisationID string                    `json:"organisation_id,omitempty"`
+	Relationships  *DirectDebitRelationships `json:"relationships,omitempty"`
+	Type           string                    `json:"type,omitempty"`
+	Version        *int64                    `json:"version,omitempty"`
+}
+
+// DirectDebitAttributes holds the details of a direct debit collected under a mandate.
+// Amounts are decimal strings (e.g. "100.21") so that they are never rounded.
+type DirectDebitAttributes struct {
+	Amount            string        `json:"amount,omitempty"`
+	BeneficiaryParty  *PaymentParty `json:"beneficiary_party,omitempty"`
+	Currency          string        `json:"currency,omitempty"`
+	DebtorParty       *PaymentParty `json:"debtor_party,omitempty"`
+	NumericReference  string        `json:"numeric_reference,omitempty"`
+	PaymentScheme     string        `json:"payment_scheme,omitempty"`
+	ProcessingDate    string        `json:"processing_date,omitempty"`
+	Reference         string        `json:"reference,omitempty"`
+	SchemePaymentType string        `json:"scheme_payment_type,omitempty"`
+	Status            string        `json:"status,omitempty"`
+}
+
+type DirectDebitRelationships struct {
+	Mandate *Relationship `json:"mandate,omitempty"`
+}
+
+// DirectDebitSubResourceRelationships links a decision, return or reversal to its direct debit.
+type DirectDebitSubResourceRelationships struct {
+	DirectDebit *Relationship `json:"direct_debit,omitempty"`
+}
+
+// DirectDebitDecisionRequest accepts or rejects an incoming direct debit before it is paid.
+type DirectDebitDecisionRequest struct {
+	Data *DirectDebitDecisionData `json:"data,omitempty"`
+}
+
+type DirectDebitDecisionResponse struct {
+	Data  *DirectDebitDecisionData `json:"data,omitempty"`
+	Links *Links                   `json:"links,omitempty"`
+}
+
+type DirectDebitDecisionData struct {
+	Attributes     *DirectDebitDecisionAttributes       `json:"attributes,omitempty"`
+	ID             string                               `json:"id,omitempty"`
+	OrganisationID string                               `json:"organisation_id,omitempty"`
+	Relationships  *DirectDebitSubResourceRelationships `json:"relationships,omitempty"`
+	Type           string                               `json:"type,omitempty"`
+	Version        *int64                               `json:"version,omitempty"`
+}
+
+// DirectDebitDecisionAttributes holds the answer to a direct debit, DirectDebitAccepted or DirectDebitRejected.
+// The reason is only set when the direct debit is rejected.
+type DirectDebitDecisionAttributes struct {
+	Answer string `json:"answer,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// DirectDebitReturnRequest returns a direct debit that was already paid, e.g. because it was not authorised.
+type DirectDebitReturnRequest struct {
+	Data *DirectDebitReturnData `json:"data,omitempty"`
+}
+
+type DirectDebitReturnResponse struct {
+	Data  *DirectDebitReturnData `json:"data,omitempty"`
+	Links *Links                 `json:"links,omitempty"`
+}
+
+type DirectDebitReturnData struct {
+	Attributes     *DirectDebitReturnAttributes         `json:"attributes,omitempty"`
+	ID             string                               `json:"id,omitempty"`
+	OrganisationID string                               `json:"organisation_id,omitempty"`
+	Relationships  *DirectDebitSubResourceRelationships `json:"relationships,omitempty"`
+	Type           string                               `json:"type,omitempty"`
+	Version        *int64                               `json:"version,omitempty"`
+}
+
+type DirectDebitReturnAttributes struct {
+	ReturnCode string `json:"return_code,omitempty"`
+	Status     string `json:"status,omitempty"`
+}
+
+// DirectDebitReversalRequest reverses a direct debit collected by mistake.
+type DirectDebitReversalRequest struct {
+	Data *DirectDebitReversalData `json:"data,omitempty"`
+}
+
+type DirectDebitReversalResponse struct {
+	Data  *DirectDebitReversalData `json:"data,omitempty"`
+	Links *Links                   `json:"links,omitempty"`
+}
+
+type DirectDebitReversalData struct {
+	Attributes     *DirectDebitReversalAttributes       `json:"attributes,omitempty"`
+	ID             string                               `json:"id,omitempty"`
+	OrganisationID string                               `json:"organisation_id,omitempty"`
+	Relationships  *DirectDebitSubResourceRelationships `json:"relationships,omitempty"`
+	Type           string                               `json:"type,omitempty"`
+	Version        *int64                               `json:"version,omitempty"`
+}
+
+type DirectDebitReversalAttributes struct {
+	Status string `json:"status,omitempty"`
+}
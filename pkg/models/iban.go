@@ -0,0 +1,409 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidIBAN            = errors.New("invalid IBAN")
+	ErrUnsupportedIBANCountry = errors.New("unsupported IBAN country")
+)
+
+// span is a [start, end) range of characters within the BBAN. A zero span means the part does not exist.
+type span struct {
+	start, end int
+}
+
+func (s span) of(bban string) string {
+	return bban[s.start:s.end]
+}
+
+// ibanSpec describes the structure of the IBANs of a country.
+type ibanSpec struct {
+	length int
+	// bban is the BBAN format in the IBAN registry notation, e.g. 4!a6!n8!n
+	bban          string
+	bankCode      span
+	branchCode    span
+	accountNumber span
+	// bankID is the part of the BBAN used as bank_id in the account attributes
+	bankID span
+	// nationalCheck computes the national check digits of the BBAN, when it has some, from its other parts
+	nationalCheck func(bban []byte)
+	pattern       *regexp.Regexp
+}
+
+// The account numbers exclude the national check digits that follow them in the BBAN, such as the FR RIB key.
+var ibanSpecs = map[string]*ibanSpec{
+	"AT": {length: 20, bban: "5!n11!n", bankCode: span{0, 5}, accountNumber: span{5, 16}, bankID: span{0, 5}},
+	"BE": {length: 16, bban: "3!n7!n2!n", bankCode: span{0, 3}, accountNumber: span{3, 10}, bankID: span{0, 3}, nationalCheck: belgianCheck},
+	"CH": {length: 21, bban: "5!n12!c", bankCode: span{0, 5}, accountNumber: span{5, 17}, bankID: span{0, 5}},
+	"DE": {length: 22, bban: "8!n10!n", bankCode: span{0, 8}, accountNumber: span{8, 18}, bankID: span{0, 8}},
+	"DK": {length: 18, bban: "4!n9!n1!n", bankCode: span{0, 4}, accountNumber: span{4, 14}, bankID: span{0, 4}},
+	"ES": {length: 24, bban: "4!n4!n1!n1!n10!n", bankCode: span{0, 4}, branchCode: span{4, 8}, accountNumber: span{10, 20}, bankID: span{0, 8}, nationalCheck: spanishCheck},
+	"FI": {length: 18, bban: "3!n11!n", bankCode: span{0, 3}, accountNumber: span{3, 14}, bankID: span{0, 3}},
+	"FR": {length: 27, bban: "5!n5!n11!c2!n", bankCode: span{0, 5}, branchCode: span{5, 10}, accountNumber: span{10, 21}, bankID: span{0, 10}, nationalCheck: ribKey},
+	"GB": {length: 22, bban: "4!a6!n8!n", bankCode: span{0, 4}, branchCode: span{4, 10}, accountNumber: span{10, 18}, bankID: span{4, 10}},
+	"GR": {length: 27, bban: "3!n4!n16!c", bankCode: span{0, 3}, branchCode: span{3, 7}, accountNumber: span{7, 23}, bankID: span{0, 7}},
+	"IE": {length: 22, bban: "4!a6!n8!n", bankCode: span{0, 4}, branchCode: span{4, 10}, accountNumber: span{10, 18}, bankID: span{4, 10}},
+	"IT": {length: 27, bban: "1!a5!n5!n12!c", bankCode: span{1, 6}, branchCode: span{6, 11}, accountNumber: span{11, 23}, bankID: span{1, 11}, nationalCheck: italianCIN},
+	"LU": {length: 20, bban: "3!n13!c", bankCode: span{0, 3}, accountNumber: span{3, 16}, bankID: span{0, 3}},
+	"NL": {length: 18, bban: "4!a10!n", bankCode: span{0, 4}, accountNumber: span{4, 14}},
+	"NO": {length: 15, bban: "4!n6!n1!n", bankCode: span{0, 4}, accountNumber: span{4, 11}, bankID: span{0, 4}},
+	"PL": {length: 28, bban: "8!n16!n", bankCode: span{0, 8}, accountNumber: span{8, 24}, bankID: span{0, 8}},
+	"PT": {length: 25, bban: "4!n4!n11!n2!n", bankCode: span{0, 4}, branchCode: span{4, 8}, accountNumber: span{8, 19}, bankID: span{0, 8}, nationalCheck: portugueseCheck},
+	"SE": {length: 24, bban: "3!n16!n1!n", bankCode: span{0, 3}, accountNumber: span{3, 20}, bankID: span{0, 3}},
+}
+
+var bbanFormatPart = regexp.MustCompile(`(\d+)!([nac])`)
+
+func init() {
+	for _, spec := range ibanSpecs {
+		spec.pattern = compileBBANFormat(spec.bban)
+	}
+}
+
+// Turns a BBAN format such as 4!a6!n into the respective regular expression.
+func compileBBANFormat(format string) *regexp.Regexp {
+	classes := map[string]string{"n": "[0-9]", "a": "[A-Z]", "c": "[A-Z0-9]"}
+
+	pattern := "^"
+	for _, part := range bbanFormatPart.FindAllStringSubmatch(format, -1) {
+		pattern += classes[part[2]] + "{" + part[1] + "}"
+	}
+
+	return regexp.MustCompile(pattern + "$")
+}
+
+// IBAN is a validated International Bank Account Number, kept in its electronic format.
+// The zero value is not a valid IBAN: its accessors return empty strings.
+type IBAN struct {
+	value string
+	spec  *ibanSpec
+}
+
+// ParseIBAN validates the IBAN length, structure and checksum for its country.
+// Spaces are ignored and letters are case insensitive.
+func ParseIBAN(value string) (IBAN, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+
+	if len(normalized) < 5 {
+		return IBAN{}, fmt.Errorf("%w: '%s' is too short", ErrInvalidIBAN, value)
+	}
+
+	spec, ok := ibanSpecs[normalized[:2]]
+	if !ok {
+		return IBAN{}, fmt.Errorf("%w: %s", ErrUnsupportedIBANCountry, normalized[:2])
+	}
+
+	if len(normalized) != spec.length {
+		return IBAN{}, fmt.Errorf("%w: %s IBANs must have %d characters", ErrInvalidIBAN, normalized[:2], spec.length)
+	}
+
+	if _, err := strconv.Atoi(normalized[2:4]); err != nil {
+		return IBAN{}, fmt.Errorf("%w: check digits must be numeric", ErrInvalidIBAN)
+	}
+
+	if !spec.pattern.MatchString(normalized[4:]) {
+		return IBAN{}, fmt.Errorf("%w: BBAN does not match the %s format %s", ErrInvalidIBAN, normalized[:2], spec.bban)
+	}
+
+	if mod97(normalized[4:]+normalized[:4]) != 1 {
+		return IBAN{}, fmt.Errorf("%w: checksum does not match", ErrInvalidIBAN)
+	}
+
+	return IBAN{value: normalized, spec: spec}, nil
+}
+
+// IBANOption sets an optional part of the IBAN built by GenerateIBAN.
+type IBANOption func(*ibanParts)
+
+type ibanParts struct {
+	bankCode string
+}
+
+// WithBankCode sets the bank code of the countries whose bank id does not include it: GB, IE and NL.
+func WithBankCode(bankCode string) IBANOption {
+	return func(parts *ibanParts) {
+		parts.bankCode = bankCode
+	}
+}
+
+// GenerateIBAN builds the IBAN of a domestic account from its bank id and account number, as returned by
+// IBAN.BankID and IBAN.AccountNumber or set by IBAN.ApplyTo, computing the national check digits of the BBAN,
+// such as the FR RIB key, and the IBAN check digits. Account numbers shorter than in the BBAN are padded with zeros.
+// The countries whose bank id does not include the bank code, GB, IE and NL, require it WithBankCode.
+// bankID and accountNumber may also make up the whole BBAN, national check digits included, e.g. NWBK601613 and 31926819.
+func GenerateIBAN(country string, bankID string, accountNumber string, opts ...IBANOption) (IBAN, error) {
+	country = strings.ToUpper(country)
+
+	spec, ok := ibanSpecs[country]
+	if !ok {
+		return IBAN{}, fmt.Errorf("%w: %s", ErrUnsupportedIBANCountry, country)
+	}
+
+	parts := &ibanParts{}
+	for _, opt := range opts {
+		opt(parts)
+	}
+
+	bban := strings.ToUpper(bankID + accountNumber)
+	if !spec.pattern.MatchString(bban) {
+		var err error
+		bban, err = spec.buildBBAN(country, strings.ToUpper(parts.bankCode), strings.ToUpper(bankID), strings.ToUpper(accountNumber))
+		if err != nil {
+			return IBAN{}, err
+		}
+	}
+
+	if !spec.pattern.MatchString(bban) {
+		return IBAN{}, fmt.Errorf("%w: BBAN does not match the %s format %s", ErrInvalidIBAN, country, spec.bban)
+	}
+
+	checkDigits := 98 - mod97(bban+country+"00")
+
+	return ParseIBAN(fmt.Sprintf("%s%02d%s", country, checkDigits, bban))
+}
+
+// Valid reports whether the IBAN was returned by ParseIBAN or GenerateIBAN, rather than being the zero value.
+func (i IBAN) Valid() bool {
+	return i.spec != nil
+}
+
+// Places the bank code, bank id and account number within the BBAN and computes its national check digits.
+func (spec *ibanSpec) buildBBAN(country string, bankCode string, bankID string, accountNumber string) (string, error) {
+	bban := make([]byte, spec.length-4)
+
+	switch {
+	case spec.bankID == span{} && bankID != "":
+		return "", fmt.Errorf("%w: %s accounts have no bank id", ErrInvalidIBAN, country)
+	case len(bankID) != spec.bankID.end-spec.bankID.start:
+		return "", fmt.Errorf("%w: %s bank ids must have %d characters", ErrInvalidIBAN, country, spec.bankID.end-spec.bankID.start)
+	}
+	copy(bban[spec.bankID.start:spec.bankID.end], bankID)
+
+	if spec.bankCode.start < spec.bankID.start || spec.bankCode.end > spec.bankID.end {
+		if len(bankCode) != spec.bankCode.end-spec.bankCode.start {
+			return "", fmt.Errorf("%w: %s IBANs require the bank code of %d characters", ErrInvalidIBAN, country, spec.bankCode.end-spec.bankCode.start)
+		}
+		copy(bban[spec.bankCode.start:spec.bankCode.end], bankCode)
+	}
+
+	width := spec.accountNumber.end - spec.accountNumber.start
+	if len(accountNumber) > width {
+		return "", fmt.Errorf("%w: %s account numbers must have up to %d characters", ErrInvalidIBAN, country, width)
+	}
+	copy(bban[spec.accountNumber.start:spec.accountNumber.end], strings.Repeat("0", width-len(accountNumber))+accountNumber)
+
+	if spec.nationalCheck != nil {
+		spec.nationalCheck(bban)
+	}
+
+	return string(bban), nil
+}
+
+// Sets the BE check digits, the remainder of the division by 97 of the bank code and account number, 97 for 0.
+func belgianCheck(bban []byte) {
+	check := mod97(string(bban[:10]))
+	if check == 0 {
+		check = 97
+	}
+	copy(bban[10:12], fmt.Sprintf("%02d", check))
+}
+
+// Sets the ES check digits, the first one of the bank and branch codes and the second one of the account number.
+func spanishCheck(bban []byte) {
+	weights := []int{1, 2, 4, 8, 5, 10, 9, 7, 3, 6}
+
+	digit := func(value string) byte {
+		sum := 0
+		for i, char := range value {
+			sum += int(char-'0') * weights[i]
+		}
+		check := 11 - sum%11
+		switch check {
+		case 11:
+			check = 0
+		case 10:
+			check = 1
+		}
+		return byte('0' + check)
+	}
+
+	bban[8] = digit("00" + string(bban[:8]))
+	bban[9] = digit(string(bban[10:20]))
+}
+
+// Sets the FR RIB key of the bank code, branch code and account number, whose letters count as digits.
+func ribKey(bban []byte) {
+	digits := make([]byte, 21)
+	for i, char := range bban[:21] {
+		switch {
+		case char >= 'A' && char <= 'R':
+			char = '1' + (char-'A')%9
+		case char >= 'S' && char <= 'Z':
+			char = '2' + (char-'S')%8
+		}
+		digits[i] = char
+	}
+
+	copy(bban[21:23], fmt.Sprintf("%02d", 97-mod97(string(digits)+"00")))
+}
+
+// Sets the IT CIN, the check letter computed from the odd and even positions of the bank code, branch code and account number.
+func italianCIN(bban []byte) {
+	oddValues := []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+	sum := 0
+	for i, char := range bban[1:23] {
+		value := int(char - 'A')
+		if char >= '0' && char <= '9' {
+			value = int(char - '0')
+		}
+		if value < 0 || value >= len(oddValues) {
+			continue
+		}
+		if i%2 == 0 {
+			value = oddValues[value]
+		}
+		sum += value
+	}
+
+	bban[0] = byte('A' + sum%26)
+}
+
+// Sets the PT check digits of the bank code, branch code and account number.
+func portugueseCheck(bban []byte) {
+	copy(bban[19:21], fmt.Sprintf("%02d", 98-mod97(string(bban[:19])+"00")))
+}
+
+// String returns the IBAN in its electronic format, without spaces.
+func (i IBAN) String() string {
+	return i.value
+}
+
+// Pretty returns the IBAN in its print format, in groups of 4 characters separated by spaces.
+func (i IBAN) Pretty() string {
+	var groups []string
+	for start := 0; start < len(i.value); start += 4 {
+		end := start + 4
+		if end > len(i.value) {
+			end = len(i.value)
+		}
+		groups = append(groups, i.value[start:end])
+	}
+	return strings.Join(groups, " ")
+}
+
+func (i IBAN) CountryCode() string {
+	if !i.Valid() {
+		return ""
+	}
+	return i.value[:2]
+}
+
+func (i IBAN) CheckDigits() string {
+	if !i.Valid() {
+		return ""
+	}
+	return i.value[2:4]
+}
+
+// BBAN returns the Basic Bank Account Number, the domestic part of the IBAN.
+func (i IBAN) BBAN() string {
+	if !i.Valid() {
+		return ""
+	}
+	return i.value[4:]
+}
+
+func (i IBAN) BankCode() string {
+	if !i.Valid() {
+		return ""
+	}
+	return i.spec.bankCode.of(i.BBAN())
+}
+
+// BranchCode returns the branch code, or an empty string when the country has none.
+func (i IBAN) BranchCode() string {
+	if !i.Valid() {
+		return ""
+	}
+	return i.spec.branchCode.of(i.BBAN())
+}
+
+func (i IBAN) AccountNumber() string {
+	if !i.Valid() {
+		return ""
+	}
+	return i.spec.accountNumber.of(i.BBAN())
+}
+
+// BankID returns the value expected in the bank_id account attribute, e.g. the sort code for GB.
+// It is empty for countries that do not use a bank id, such as NL.
+func (i IBAN) BankID() string {
+	if !i.Valid() {
+		return ""
+	}
+	return i.spec.bankID.of(i.BBAN())
+}
+
+// ApplyTo fills the IBAN, country, bank id and account number of the account attributes from the IBAN.
+// The account number is mapped to the account number rule of the country scheme, see Validate,
+// and left empty when it does not fit that rule. The zero value leaves the attributes untouched.
+func (i IBAN) ApplyTo(attributes *AccountAttributes) {
+	if !i.Valid() {
+		return
+	}
+
+	country := i.CountryCode()
+
+	attributes.Iban = i.String()
+	attributes.Country = &country
+	attributes.BankID = i.BankID()
+	attributes.AccountNumber = schemeAccountNumber(country, i.AccountNumber())
+}
+
+// Maps the account number of an IBAN to the account number rule of the country scheme, dropping the leading
+// zeros that the rule does not allow, e.g. from the 11 characters of the FR IBANs to the 10 of the scheme.
+// Returns an empty string when the account number does not fit the rule even so.
+func schemeAccountNumber(country string, accountNumber string) string {
+	rule, known := countryRules[country]
+	if !known {
+		return accountNumber
+	}
+
+	for !rule.accountNumber.MatchString(accountNumber) {
+		if !strings.HasPrefix(accountNumber, "0") {
+			return ""
+		}
+		accountNumber = accountNumber[1:]
+	}
+
+	return accountNumber
+}
+
+// Computes the remainder of the division by 97 of the number obtained by replacing each letter by two digits.
+func mod97(value string) int {
+	remainder := 0
+
+	for _, char := range value {
+		var digits int
+		switch {
+		case char >= '0' && char <= '9':
+			remainder = (remainder*10 + int(char-'0')) % 97
+			continue
+		case char >= 'A' && char <= 'Z':
+			digits = int(char-'A') + 10
+		default:
+			return -1
+		}
+		remainder = (remainder*100 + digits) % 97
+	}
+
+	return remainder
+}
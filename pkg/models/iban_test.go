@@ -0,0 +1,288 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// exampleIBANs holds a valid IBAN of each supported country, taken from the IBAN registry.
+var exampleIBANs = map[string]string{
+	"AT": "AT611904300234573201",
+	"BE": "BE68539007547034",
+	"CH": "CH9300762011623852957",
+	"DE": "DE89370400440532013000",
+	"DK": "DK5000400440116243",
+	"ES": "ES9121000418450200051332",
+	"FI": "FI2112345600000785",
+	"FR": "FR1420041010050500013M02606",
+	"GB": "GB29NWBK60161331926819",
+	"GR": "GR1601101250000000012300695",
+	"IE": "IE29AIBK93115212345678",
+	"IT": "IT60X0542811101000000123456",
+	"LU": "LU280019400644750000",
+	"NL": "NL91ABNA0417164300",
+	"NO": "NO9386011117947",
+	"PL": "PL61109010140000071219812874",
+	"PT": "PT50000201231234567890154",
+	"SE": "SE4550000000058398257466",
+}
+
+func TestParseIBAN(t *testing.T) {
+
+	for _, value := range exampleIBANs {
+		t.Run("Given a valid IBAN should parse it", func(t *testing.T) {
+			// Act
+			iban, err := ParseIBAN(value)
+
+			// Assert
+			assert.Nil(t, err, value)
+			assert.True(t, iban.Valid())
+			assert.Equal(t, value, iban.String())
+		})
+	}
+
+	t.Run("Given an IBAN in print format should normalize it", func(t *testing.T) {
+		// Act
+		iban, err := ParseIBAN("gb29 nwbk 6016 1331 9268 19")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "GB29NWBK60161331926819", iban.String())
+		assert.Equal(t, "GB29 NWBK 6016 1331 9268 19", iban.Pretty())
+	})
+
+	testCases := []struct {
+		description string
+		value       string
+		err         error
+	}{
+		{"a wrong checksum", "GB11NWBK40030041426819", ErrInvalidIBAN},
+		{"a wrong length", "GB29NWBK6016133192681", ErrInvalidIBAN},
+		{"a BBAN not matching the country format", "GB29NWBK6016133192681A", ErrInvalidIBAN},
+		{"non numeric check digits", "GBXXNWBK60161331926819", ErrInvalidIBAN},
+		{"a too short value", "GB", ErrInvalidIBAN},
+		{"an unsupported country", "XX29NWBK60161331926819", ErrUnsupportedIBANCountry},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given an IBAN with "+tc.description+" should return an error", func(t *testing.T) {
+			// Act
+			_, err := ParseIBAN(tc.value)
+
+			// Assert
+			assert.True(t, errors.Is(err, tc.err), err)
+		})
+	}
+}
+
+func TestIBANParts(t *testing.T) {
+	testCases := []struct {
+		value         string
+		bankCode      string
+		branchCode    string
+		accountNumber string
+		bankID        string
+	}{
+		{"GB29NWBK60161331926819", "NWBK", "601613", "31926819", "601613"},
+		{"DE89370400440532013000", "37040044", "", "0532013000", "37040044"},
+		{"FR1420041010050500013M02606", "20041", "01005", "0500013M026", "2004101005"},
+		{"IT60X0542811101000000123456", "05428", "11101", "000000123456", "0542811101"},
+		{"ES9121000418450200051332", "2100", "0418", "0200051332", "21000418"},
+		{"NL91ABNA0417164300", "ABNA", "", "0417164300", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given a valid IBAN should extract the BBAN parts", func(t *testing.T) {
+			// Arrange
+			iban, err := ParseIBAN(tc.value)
+			assert.Nil(t, err)
+
+			// Act & Assert
+			assert.Equal(t, tc.value[:2], iban.CountryCode())
+			assert.Equal(t, tc.value[2:4], iban.CheckDigits())
+			assert.Equal(t, tc.value[4:], iban.BBAN())
+			assert.Equal(t, tc.bankCode, iban.BankCode())
+			assert.Equal(t, tc.branchCode, iban.BranchCode())
+			assert.Equal(t, tc.accountNumber, iban.AccountNumber())
+			assert.Equal(t, tc.bankID, iban.BankID())
+		})
+	}
+
+	t.Run("Given a valid IBAN should apply it to the account attributes", func(t *testing.T) {
+		// Arrange
+		iban, _ := ParseIBAN("GB29NWBK60161331926819")
+		attributes := &AccountAttributes{}
+
+		// Act
+		iban.ApplyTo(attributes)
+
+		// Assert
+		assert.Equal(t, "GB29NWBK60161331926819", attributes.Iban)
+		assert.Equal(t, "GB", *attributes.Country)
+		assert.Equal(t, "601613", attributes.BankID)
+		assert.Equal(t, "31926819", attributes.AccountNumber)
+	})
+
+	schemeTestCases := []struct {
+		description   string
+		value         string
+		accountNumber string
+	}{
+		{"an account number with a leading zero not allowed by the scheme", "FR1420041010050500013M02606", "500013M026"},
+		{"an account number with leading zeros not allowed by the scheme", "DE33370400440001234567", "1234567"},
+		{"an account number not fitting the scheme", "DE89370400440532013000", ""},
+		{"an account number of a country without scheme rules", "SE4550000000058398257466", "00000058398257466"},
+	}
+
+	for _, tc := range schemeTestCases {
+		t.Run("Given an IBAN with "+tc.description+" should apply the account number of the scheme", func(t *testing.T) {
+			// Arrange
+			iban, err := ParseIBAN(tc.value)
+			assert.Nil(t, err)
+			attributes := &AccountAttributes{}
+
+			// Act
+			iban.ApplyTo(attributes)
+
+			// Assert
+			assert.Equal(t, tc.accountNumber, attributes.AccountNumber)
+			assert.Equal(t, iban.BankID(), attributes.BankID)
+		})
+	}
+
+	for country := range ibanSpecs {
+		t.Run("Given a valid "+country+" IBAN applied to the account attributes should pass the country validation", func(t *testing.T) {
+			// Arrange
+			value, ok := exampleIBANs[country]
+			if !ok {
+				t.Fatalf("there is no example IBAN for %s", country)
+			}
+			iban, err := ParseIBAN(value)
+			assert.Nil(t, err)
+
+			rule := countryRules[country]
+			attributes := &AccountAttributes{BankIDCode: rule.bankIDCode, Name: []string{"Daniel"}}
+			if rule.bicRequired {
+				attributes.Bic = "NWBK" + country + "22"
+			}
+
+			// Act
+			iban.ApplyTo(attributes)
+			err = (&AccountRequest{Data: &AccountData{Attributes: attributes}}).Validate()
+
+			// Assert
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestZeroIBAN(t *testing.T) {
+	t.Run("Given the zero value should not be valid and return empty parts", func(t *testing.T) {
+		// Arrange
+		sut := IBAN{}
+		attributes := &AccountAttributes{AccountNumber: "31926819"}
+
+		// Act
+		sut.ApplyTo(attributes)
+
+		// Assert
+		assert.False(t, sut.Valid())
+		assert.Empty(t, sut.String())
+		assert.Empty(t, sut.Pretty())
+		assert.Empty(t, sut.CountryCode())
+		assert.Empty(t, sut.CheckDigits())
+		assert.Empty(t, sut.BBAN())
+		assert.Empty(t, sut.BankCode())
+		assert.Empty(t, sut.BranchCode())
+		assert.Empty(t, sut.AccountNumber())
+		assert.Empty(t, sut.BankID())
+		assert.Equal(t, &AccountAttributes{AccountNumber: "31926819"}, attributes)
+	})
+}
+
+func TestGenerateIBAN(t *testing.T) {
+	t.Run("Given a domestic account should generate its IBAN", func(t *testing.T) {
+		// Act
+		iban, err := GenerateIBAN("gb", "NWBK601613", "31926819")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "GB29NWBK60161331926819", iban.String())
+	})
+
+	t.Run("Given a domestic account with letters should generate its IBAN", func(t *testing.T) {
+		// Act
+		iban, err := GenerateIBAN("FR", "2004101005", "0500013M02606")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "FR1420041010050500013M02606", iban.String())
+	})
+
+	for country := range ibanSpecs {
+		t.Run("Given the parts of a valid "+country+" IBAN should generate it back", func(t *testing.T) {
+			// Arrange
+			iban, err := ParseIBAN(exampleIBANs[country])
+			assert.Nil(t, err)
+
+			// Act
+			actual, err := GenerateIBAN(country, iban.BankID(), iban.AccountNumber(), WithBankCode(iban.BankCode()))
+
+			// Assert
+			assert.Nil(t, err)
+			assert.Equal(t, iban, actual)
+		})
+
+		t.Run("Given the account attributes set by a valid "+country+" IBAN should generate it back", func(t *testing.T) {
+			// Arrange
+			iban, err := ParseIBAN(exampleIBANs[country])
+			assert.Nil(t, err)
+			attributes := &AccountAttributes{}
+			iban.ApplyTo(attributes)
+			if attributes.AccountNumber == "" {
+				t.Skipf("the %s account number does not fit the scheme", country)
+			}
+
+			// Act
+			actual, err := GenerateIBAN(*attributes.Country, attributes.BankID, attributes.AccountNumber, WithBankCode(iban.BankCode()))
+
+			// Assert
+			assert.Nil(t, err)
+			assert.Equal(t, iban, actual)
+		})
+	}
+
+	t.Run("Given a GB account without its bank code should return an error", func(t *testing.T) {
+		// Act
+		_, err := GenerateIBAN("GB", "601613", "31926819")
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrInvalidIBAN))
+	})
+
+	t.Run("Given a bank id for a country without bank ids should return an error", func(t *testing.T) {
+		// Act
+		_, err := GenerateIBAN("NL", "1234", "0417164300", WithBankCode("ABNA"))
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrInvalidIBAN))
+	})
+
+	t.Run("Given an account not matching the country format should return an error", func(t *testing.T) {
+		// Act
+		_, err := GenerateIBAN("DE", "3704004", "0532013000")
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrInvalidIBAN))
+	})
+
+	t.Run("Given an unsupported country should return an error", func(t *testing.T) {
+		// Act
+		_, err := GenerateIBAN("US", "123456789", "123456")
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrUnsupportedIBANCountry))
+	})
+}
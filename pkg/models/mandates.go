@@ -0,0 +1,111 @@
+package models
+
+// MandateRequest sets up a direct debit mandate, authorising the beneficiary to collect from the debtor account.
+type MandateRequest struct {
+	Data *MandateData `json:"data,omitempty"`
+}
+
+type MandateResponse struct {
+	Data  *MandateData `json:"data,omitempty"`
+	Links *Links       `json:"links,omitempty"`
+}
+
+type MandateListResponse struct {
+	Data  []*MandateData `json:"data,omitempty"`
+	Links *Links         `json:"links,omitempty"`
+}
+
+type MandateData struct {
+	Attributes     *MandateAttributes `json:"attributes,omitempty"`
+	ID             string             `json:"id,omitempty"`
+	OrganisationID string             `json:"organisation_id,omitempty"`
+	Type           string             `json:"type,omitempty"`
+	Version        *int64             `json:"version,omitempty"`
+}
+
+type MandateAttributes struct {
+	BeneficiaryParty     *PaymentParty `json:"beneficiary_party,omitempty"`
+	ClearingID           string        `json:"clearing_id,omitempty"`
+	DebtorParty          *PaymentParty `json:"debtor_party,omitempty"`
+	PaymentScheme        string        `json:"payment_scheme,omitempty"`
+	Reference            string        `json:"reference,omitempty"`
+	SchemeProcessingType string        `json:"scheme_processing_type,omitempty"`
+	SignatureDate        string        `json:"signature_date,omitempty"`
+	Status               string        `json:"status,omitempty"`
+	StatusReason         string        `json:"status_reason,omitempty"`
+}
+
+// MandateRelationships links a mandate sub-resource to its mandate.
+type MandateRelationships struct {
+	Mandate *Relationship `json:"mandate,omitempty"`
+}
+
+// MandateCancellationRequest cancels a mandate, so that no more direct debits are collected with it.
+type MandateCancellationRequest struct {
+	Data *MandateCancellationData `json:"data,omitempty"`
+}
+
+type MandateCancellationResponse struct {
+	Data  *MandateCancellationData `json:"data,omitempty"`
+	Links *Links                   `json:"links,omitempty"`
+}
+
+type MandateCancellationData struct {
+	Attributes     *MandateCancellationAttributes `json:"attributes,omitempty"`
+	ID             string                         `json:"id,omitempty"`
+	OrganisationID string                         `json:"organisation_id,omitempty"`
+	Relationships  *MandateRelationships          `json:"relationships,omitempty"`
+	Type           string                         `json:"type,omitempty"`
+	Version        *int64                         `json:"version,omitempty"`
+}
+
+type MandateCancellationAttributes struct {
+	CancellationCode string `json:"cancellation_code,omitempty"`
+	Reason           string `json:"reason,omitempty"`
+	Status           string `json:"status,omitempty"`
+}
+
+// MandateAdmissionRequest records the admission of a mandate received from the scheme.
+type MandateAdmissionRequest struct {
+	Data *MandateAdmissionData `json:"data,omitempty"`
+}
+
+type MandateAdmissionResponse struct {
+	Data  *MandateAdmissionData `json:"data,omitempty"`
+	Links *Links                `json:"links,omitempty"`
+}
+
+type MandateAdmissionData struct {
+	Attributes     *MandateAdmissionAttributes `json:"attributes,omitempty"`
+	ID             string                      `json:"id,omitempty"`
+	OrganisationID string                      `json:"organisation_id,omitempty"`
+	Relationships  *MandateRelationships       `json:"relationships,omitempty"`
+	Type           string                      `json:"type,omitempty"`
+	Version        *int64                      `json:"version,omitempty"`
+}
+
+type MandateAdmissionAttributes struct {
+	AdmissionDatetime string `json:"admission_datetime,omitempty"`
+	SchemeStatusCode  string `json:"scheme_status_code,omitempty"`
+	Status            string `json:"status,omitempty"`
+	StatusReason      string `json:"status_reason,omitempty"`
+}
+
+// MandateSubmissionRequest asks for a mandate to be submitted to the scheme.
+type MandateSubmissionRequest struct {
+	Data *MandateSubmissionData `json:"data,omitempty"`
+}
+
+type MandateSubmissionResponse struct {
+	Data  *MandateSubmissionData `json:"data,omitempty"`
+	Links *Links                 `json:"links,omitempty"`
+}
+
+type MandateSubmissionData struct {
+	Attributes     *PaymentSubmissionAttributes `json:"attributes,omitempty"`
+	ID             string                       `json:"id,omitempty"`
+	OrganisationID string                       `json:"organisation_id,omitempty"`
+	Relationships  *MandateRelationships        `json:"relationships,omitempty"`
+	Type           string                       `json:"type,omitempty"`
+	Version        *int64                       `json:"version,omitempty"`
+}
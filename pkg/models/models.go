@@ -9,6 +9,19 @@ type AccountResponse struct{
 	Links *Links `json:"links,omitempty"`
 }
 
+type AccountListResponse struct{
+	Data []*AccountData `json:"data,omitempty"`
+	Links *Links `json:"links,omitempty"`
+}
+
+// Statuses of an account. Accounts are created pending and become confirmed or failed once processed.
+const(
+	AccountStatusPending string = "pending"
+	AccountStatusConfirmed string = "confirmed"
+	AccountStatusFailed string = "failed"
+	AccountStatusClosed string = "closed"
+)
+
 type AccountData struct {
 	Attributes     *AccountAttributes `json:"attributes,omitempty"`
 	ID             string             `json:"id,omitempty"`
@@ -17,25 +30,61 @@ type AccountData struct {
 	Version        *int64             `json:"version,omitempty"`
 }
 
+// AccountAttributes holds the details of an account. The fields identifying the account holder
+// are tagged with their core.Redact policy, so that they are never logged in clear.
 type AccountAttributes struct {
 	AccountClassification   *string  `json:"account_classification,omitempty"`
 	AccountMatchingOptOut   *bool    `json:"account_matching_opt_out,omitempty"`
-	AccountNumber           string   `json:"account_number,omitempty"`
-	AlternativeNames        []string `json:"alternative_names,omitempty"`
+	AccountNumber           string   `json:"account_number,omitempty" redact:"last4"`
+	AlternativeNames        []string `json:"alternative_names,omitempty" redact:"full"`
 	BankID                  string   `json:"bank_id,omitempty"`
 	BankIDCode              string   `json:"bank_id_code,omitempty"`
 	BaseCurrency            string   `json:"base_currency,omitempty"`
 	Bic                     string   `json:"bic,omitempty"`
 	Country                 *string  `json:"country,omitempty"`
-	Iban                    string   `json:"iban,omitempty"`
+	CustomerID              string   `json:"customer_id,omitempty"`
+	Iban                    string   `json:"iban,omitempty" redact:"last4"`
 	JointAccount            *bool    `json:"joint_account,omitempty"`
-	Name                    []string `json:"name,omitempty"`
-	SecondaryIdentification string   `json:"secondary_identification,omitempty"`
+	Name                    []string `json:"name,omitempty" redact:"full"`
+	SecondaryIdentification string   `json:"secondary_identification,omitempty" redact:"full"`
 	Status                  *string  `json:"status,omitempty"`
 	Switched                *bool    `json:"switched,omitempty"`
 }
 
 
+// AccountPatchRequest is the body of an account update.
+type AccountPatchRequest struct{
+	Data *AccountPatchData `json:"data,omitempty"`
+}
+
+type AccountPatchData struct {
+	Attributes *AccountPatchAttributes `json:"attributes,omitempty"`
+	ID         string                  `json:"id,omitempty"`
+	Type       string                  `json:"type,omitempty"`
+	Version    *int64                  `json:"version,omitempty"`
+}
+
+// AccountPatchAttributes holds the attributes to change in an account update.
+// Nil fields are left untouched, while fields pointing to a zero value (e.g. "" or false) are set to it.
+type AccountPatchAttributes struct {
+	AccountClassification   *string   `json:"account_classification,omitempty"`
+	AccountMatchingOptOut   *bool     `json:"account_matching_opt_out,omitempty"`
+	AccountNumber           *string   `json:"account_number,omitempty" redact:"last4"`
+	AlternativeNames        *[]string `json:"alternative_names,omitempty" redact:"full"`
+	BankID                  *string   `json:"bank_id,omitempty"`
+	BankIDCode              *string   `json:"bank_id_code,omitempty"`
+	BaseCurrency            *string   `json:"base_currency,omitempty"`
+	Bic                     *string   `json:"bic,omitempty"`
+	Country                 *string   `json:"country,omitempty"`
+	CustomerID              *string   `json:"customer_id,omitempty"`
+	Iban                    *string   `json:"iban,omitempty" redact:"last4"`
+	JointAccount            *bool     `json:"joint_account,omitempty"`
+	Name                    *[]string `json:"name,omitempty" redact:"full"`
+	SecondaryIdentification *string   `json:"secondary_identification,omitempty" redact:"full"`
+	Status                  *string   `json:"status,omitempty"`
+	Switched                *bool     `json:"switched,omitempty"`
+}
+
 type Links struct {
 	First *string `json:"first,omitempty"`
 	Last *string `json:"last,omitempty"`
@@ -44,6 +93,16 @@ type Links struct {
 	Self *string `json:"self"`
 }
 
+// Relationship links a resource to other resources, e.g. a payment submission to its payment.
+type Relationship struct {
+	Data []*ResourceIdentifier `json:"data,omitempty"`
+}
+
+type ResourceIdentifier struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
 type APIError struct {
 	ErrorMessage string `json:"error_message,omitempty"`
 }
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountPatchAttributes(t *testing.T) {
+	t.Run("Given fields set to zero values should marshal them and leave unset fields out", func(t *testing.T) {
+		// Arrange
+		patch := &AccountPatchAttributes{
+			AccountNumber:    String(""),
+			JointAccount:     Bool(false),
+			AlternativeNames: Strings(),
+		}
+
+		// Act
+		actual, err := json.Marshal(patch)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"account_number":"","joint_account":false,"alternative_names":[]}`, string(actual))
+	})
+}
+
+func TestAccountAttributesRedaction(t *testing.T) {
+	t.Run("Given an account should redact the fields identifying its holder", func(t *testing.T) {
+		// Arrange
+		account := &AccountResponse{
+			Data: &AccountData{
+				ID: "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc",
+				Attributes: &AccountAttributes{
+					AccountNumber:           "41426819",
+					AlternativeNames:        []string{"Sam Holder"},
+					BankID:                  "400300",
+					Bic:                     "NWBKGB22",
+					Iban:                    "GB11NWBK40030041426819",
+					Name:                    []string{"Samantha Holder"},
+					SecondaryIdentification: "A1B2C3D4",
+				},
+			},
+		}
+
+		// Act
+		actual := core.Redact(account).(*AccountResponse)
+
+		// Assert
+		attributes := actual.Data.Attributes
+		assert.Equal(t, "****6819", attributes.AccountNumber)
+		assert.Equal(t, "******************6819", attributes.Iban)
+		assert.Equal(t, []string{"[REDACTED]"}, attributes.AlternativeNames)
+		assert.Equal(t, []string{"[REDACTED]"}, attributes.Name)
+		assert.Equal(t, "[REDACTED]", attributes.SecondaryIdentification)
+		assert.Equal(t, "400300", attributes.BankID)
+		assert.Equal(t, "NWBKGB22", attributes.Bic)
+		assert.Equal(t, "ad27e265-9605-4b4b-a0e5-3003ea9cc4dc", actual.Data.ID)
+		assert.Equal(t, "GB11NWBK40030041426819", account.Data.Attributes.Iban)
+	})
+
+	t.Run("Given an account update should redact the fields identifying its holder", func(t *testing.T) {
+		// Arrange
+		patch := &AccountPatchAttributes{
+			Iban: String("GB11NWBK40030041426819"),
+			Name: Strings("Samantha Holder"),
+		}
+
+		// Act
+		actual := core.Redact(patch).(*AccountPatchAttributes)
+
+		// Assert
+		assert.Equal(t, "******************6819", *actual.Iban)
+		assert.Equal(t, []string{"[REDACTED]"}, *actual.Name)
+		assert.Nil(t, actual.AccountNumber)
+		assert.Equal(t, "GB11NWBK40030041426819", *patch.Iban)
+	})
+}
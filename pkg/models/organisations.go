@@ -0,0 +1,137 @@
+package models
+
+// Address types of an organisation or organisation unit.
+const (
+	AddressTypeRegistered     string = "registered"
+	AddressTypeCorrespondence string = "correspondence"
+)
+
+type Address struct {
+	AddressLines []string `json:"address_lines,omitempty" redact:"full"`
+	City         string   `json:"city,omitempty"`
+	Country      string   `json:"country,omitempty"`
+	PostCode     string   `json:"post_code,omitempty" redact:"full"`
+	Type         string   `json:"type,omitempty"`
+}
+
+// OrganisationRequest creates an organisation, which owns accounts and the other resources through their OrganisationID.
+type OrganisationRequest struct {
+	Data *OrganisationData `json:"data,omitempty"`
+}
+
+type OrganisationResponse struct {
+	Data  *OrganisationData `json:"data,omitempty"`
+	Links *Links            `json:"links,omitempty"`
+}
+
+type OrganisationListResponse struct {
+	Data  []*OrganisationData `json:"data,omitempty"`
+	Links *Links              `json:"links,omitempty"`
+}
+
+type OrganisationData struct {
+	Attributes    *OrganisationAttributes    `json:"attributes,omitempty"`
+	ID            string                     `json:"id,omitempty"`
+	Relationships *OrganisationRelationships `json:"relationships,omitempty"`
+	Type          string                     `json:"type,omitempty"`
+	Version       *int64                     `json:"version,omitempty"`
+}
+
+type OrganisationAttributes struct {
+	Addresses []*Address `json:"addresses,omitempty"`
+	Country   string     `json:"country,omitempty"`
+	LegalName string     `json:"legal_name,omitempty" redact:"full"`
+	Name      string     `json:"name,omitempty" redact:"full"`
+	Status    string     `json:"status,omitempty"`
+}
+
+// OrganisationRelationships places an organisation or an organisation unit under its parent.
+type OrganisationRelationships struct {
+	Parent *Relationship `json:"parent,omitempty"`
+}
+
+// NewParentRelationship returns the relationships placing a resource under the parent of the given id and type,
+// e.g. "organisations" or "organisation_units".
+func NewParentRelationship(parentID string, parentType string) *OrganisationRelationships {
+	return &OrganisationRelationships{
+		Parent: &Relationship{Data: []*ResourceIdentifier{{ID: parentID, Type: parentType}}},
+	}
+}
+
+// ParentID returns the id of the parent, or an empty string for a resource at the top of the hierarchy.
+func (r *OrganisationRelationships) ParentID() string {
+	if r == nil || r.Parent == nil || len(r.Parent.Data) == 0 {
+		return ""
+	}
+	return r.Parent.Data[0].ID
+}
+
+// OrganisationPatchRequest is the body of an organisation update.
+type OrganisationPatchRequest struct {
+	Data *OrganisationPatchData `json:"data,omitempty"`
+}
+
+type OrganisationPatchData struct {
+	Attributes *OrganisationPatchAttributes `json:"attributes,omitempty"`
+	ID         string                       `json:"id,omitempty"`
+	Type       string                       `json:"type,omitempty"`
+	Version    *int64                       `json:"version,omitempty"`
+}
+
+// OrganisationPatchAttributes holds the attributes to change in an organisation update. Nil fields are left untouched,
+// while a non nil Addresses replaces all the addresses.
+type OrganisationPatchAttributes struct {
+	Addresses []*Address `json:"addresses,omitempty"`
+	LegalName *string    `json:"legal_name,omitempty" redact:"full"`
+	Name      *string    `json:"name,omitempty" redact:"full"`
+}
+
+// OrganisationUnitRequest creates a unit of an organisation, such as a branch or a department.
+// Units are nested under a parent unit, or directly under the organisation when they have no parent.
+type OrganisationUnitRequest struct {
+	Data *OrganisationUnitData `json:"data,omitempty"`
+}
+
+type OrganisationUnitResponse struct {
+	Data  *OrganisationUnitData `json:"data,omitempty"`
+	Links *Links                `json:"links,omitempty"`
+}
+
+type OrganisationUnitListResponse struct {
+	Data  []*OrganisationUnitData `json:"data,omitempty"`
+	Links *Links                  `json:"links,omitempty"`
+}
+
+type OrganisationUnitData struct {
+	Attributes     *OrganisationUnitAttributes `json:"attributes,omitempty"`
+	ID             string                      `json:"id,omitempty"`
+	OrganisationID string                      `json:"organisation_id,omitempty"`
+	Relationships  *OrganisationRelationships  `json:"relationships,omitempty"`
+	Type           string                      `json:"type,omitempty"`
+	Version        *int64                      `json:"version,omitempty"`
+}
+
+type OrganisationUnitAttributes struct {
+	Addresses []*Address `json:"addresses,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	Status    string     `json:"status,omitempty"`
+}
+
+// OrganisationUnitPatchRequest is the body of an organisation unit update.
+type OrganisationUnitPatchRequest struct {
+	Data *OrganisationUnitPatchData `json:"data,omitempty"`
+}
+
+type OrganisationUnitPatchData struct {
+	Attributes *OrganisationUnitPatchAttributes `json:"attributes,omitempty"`
+	ID         string                           `json:"id,omitempty"`
+	Type       string                           `json:"type,omitempty"`
+	Version    *int64                           `json:"version,omitempty"`
+}
+
+// OrganisationUnitPatchAttributes holds the attributes to change in an organisation unit update.
+// Nil fields are left untouched, while a non nil Addresses replaces all the addresses.
+type OrganisationUnitPatchAttributes struct {
+	Addresses []*Address `json:"addresses,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParentID(t *testing.T) {
+	t.Run("Given relationships with a parent should return its id", func(t *testing.T) {
+		// Arrange
+		sut := NewParentRelationship("parent-id", "organisations")
+
+		// Act
+		actual := sut.ParentID()
+
+		// Assert
+		assert.Equal(t, "parent-id", actual)
+		assert.Equal(t, "organisations", sut.Parent.Data[0].Type)
+	})
+
+	t.Run("Given no relationships should return an empty id", func(t *testing.T) {
+		// Arrange
+		var sut *OrganisationRelationships
+
+		// Act
+		actual := sut.ParentID()
+
+		// Assert
+		assert.Empty(t, actual)
+	})
+}
+
+func TestOrganisationAttributesRedaction(t *testing.T) {
+	t.Run("Given an organisation should redact its names and the lines and post code of its addresses", func(t *testing.T) {
+		// Arrange
+		organisation := &OrganisationAttributes{
+			Addresses: []*Address{{AddressLines: []string{"1 The Street"}, City: "London", Country: "GB", PostCode: "EC1A 1BB", Type: AddressTypeRegistered}},
+			Country:   "GB",
+			LegalName: "Samantha Holder Ltd",
+			Name:      "Samantha Holder",
+		}
+
+		// Act
+		actual := core.Redact(organisation).(*OrganisationAttributes)
+
+		// Assert
+		assert.Equal(t, "[REDACTED]", actual.LegalName)
+		assert.Equal(t, "[REDACTED]", actual.Name)
+		assert.Equal(t, []string{"[REDACTED]"}, actual.Addresses[0].AddressLines)
+		assert.Equal(t, "[REDACTED]", actual.Addresses[0].PostCode)
+		assert.Equal(t, "London", actual.Addresses[0].City)
+		assert.Equal(t, "1 The Street", organisation.Addresses[0].AddressLines[0])
+	})
+}
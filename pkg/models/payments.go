@@ -0,0 +1,163 @@
+package models
+
+// Payment schemes supported by the payments API.
+const (
+	SchemeFPS    string = "FPS"
+	SchemeBacs   string = "Bacs"
+	SchemeSEPACT string = "SEPACT"
+	SchemeSWIFT  string = "SWIFT"
+)
+
+// Charge bearer codes, telling who pays the charges of a payment.
+const (
+	BearerCodeDebtor   string = "DEBT"
+	BearerCodeCreditor string = "CRED"
+	BearerCodeShared   string = "SHAR"
+	BearerCodeService  string = "SLEV"
+)
+
+type PaymentRequest struct {
+	Data *PaymentData `json:"data,omitempty"`
+}
+
+type PaymentResponse struct {
+	Data  *PaymentData `json:"data,omitempty"`
+	Links *Links       `json:"links,omitempty"`
+}
+
+type PaymentListResponse struct {
+	Data  []*PaymentData `json:"data,omitempty"`
+	Links *Links         `json:"links,omitempty"`
+}
+
+type PaymentData struct {
+	Attributes     *PaymentAttributes `json:"attributes,omitempty"`
+	ID             string             `json:"id,omitempty"`
+	OrganisationID string             `json:"organisation_id,omitempty"`
+	Type           string             `json:"type,omitempty"`
+	Version        *int64             `json:"version,omitempty"`
+}
+
+// PaymentAttributes holds the details of a payment.
+// Amounts are decimal strings (e.g. "100.21") so that they are never rounded.
+type PaymentAttributes struct {
+	Amount               string              `json:"amount,omitempty"`
+	BeneficiaryParty     *PaymentParty       `json:"beneficiary_party,omitempty"`
+	ChargesInformation   *ChargesInformation `json:"charges_information,omitempty"`
+	Currency             string              `json:"currency,omitempty"`
+	DebtorParty          *PaymentParty       `json:"debtor_party,omitempty"`
+	EndToEndReference    string              `json:"end_to_end_reference,omitempty"`
+	FX                   *FX                 `json:"fx,omitempty"`
+	NumericReference     string              `json:"numeric_reference,omitempty"`
+	PaymentPurpose       string              `json:"payment_purpose,omitempty"`
+	PaymentScheme        string              `json:"payment_scheme,omitempty"`
+	PaymentType          string              `json:"payment_type,omitempty"`
+	ProcessingDate       string              `json:"processing_date,omitempty"`
+	Reference            string              `json:"reference,omitempty"`
+	SchemePaymentSubType string              `json:"scheme_payment_sub_type,omitempty"`
+	SchemePaymentType    string              `json:"scheme_payment_type,omitempty"`
+	UniqueSchemeID       string              `json:"unique_scheme_id,omitempty"`
+}
+
+// PaymentParty is the debtor or the beneficiary of a payment.
+type PaymentParty struct {
+	AccountName       string   `json:"account_name,omitempty" redact:"full"`
+	AccountNumber     string   `json:"account_number,omitempty" redact:"last4"`
+	AccountNumberCode string   `json:"account_number_code,omitempty"`
+	AccountType       *int     `json:"account_type,omitempty"`
+	AccountWith       *BankID  `json:"account_with,omitempty"`
+	Address           []string `json:"address,omitempty" redact:"full"`
+	BankID            string   `json:"bank_id,omitempty"`
+	BankIDCode        string   `json:"bank_id_code,omitempty"`
+	Country           string   `json:"country,omitempty"`
+	Name              string   `json:"name,omitempty" redact:"full"`
+}
+
+// BankID identifies the bank holding an account.
+type BankID struct {
+	BankID     string `json:"bank_id,omitempty"`
+	BankIDCode string `json:"bank_id_code,omitempty"`
+	Bic        string `json:"bic,omitempty"`
+}
+
+type ChargesInformation struct {
+	BearerCode              string    `json:"bearer_code,omitempty"`
+	ReceiverChargesAmount   string    `json:"receiver_charges_amount,omitempty"`
+	ReceiverChargesCurrency string    `json:"receiver_charges_currency,omitempty"`
+	SenderCharges           []*Charge `json:"sender_charges,omitempty"`
+}
+
+type Charge struct {
+	Amount   string `json:"amount,omitempty"`
+	Currency string `json:"currency,omitempty"`
+}
+
+// FX holds the foreign exchange details of a payment made in another currency than the debtor account.
+type FX struct {
+	ContractReference string `json:"contract_reference,omitempty"`
+	ExchangeRate      string `json:"exchange_rate,omitempty"`
+	OriginalAmount    string `json:"original_amount,omitempty"`
+	OriginalCurrency  string `json:"original_currency,omitempty"`
+}
+
+// PaymentSubmissionRequest asks for a payment to be submitted to its scheme.
+type PaymentSubmissionRequest struct {
+	Data *PaymentSubmissionData `json:"data,omitempty"`
+}
+
+type PaymentSubmissionResponse struct {
+	Data  *PaymentSubmissionData `json:"data,omitempty"`
+	Links *Links                 `json:"links,omitempty"`
+}
+
+type PaymentSubmissionData struct {
+	Attributes     *PaymentSubmissionAttributes `json:"attributes,omitempty"`
+	ID             string                       `json:"id,omitempty"`
+	OrganisationID string                       `json:"organisation_id,omitempty"`
+	Relationships  *SubmissionRelationships     `json:"relationships,omitempty"`
+	Type           string                       `json:"type,omitempty"`
+	Version        *int64                       `json:"version,omitempty"`
+}
+
+type PaymentSubmissionAttributes struct {
+	SchemeStatusCode            string `json:"scheme_status_code,omitempty"`
+	SchemeStatusCodeDescription string `json:"scheme_status_code_description,omitempty"`
+	SettlementCycle             *int   `json:"settlement_cycle,omitempty"`
+	SettlementDate              string `json:"settlement_date,omitempty"`
+	Status                      string `json:"status,omitempty"`
+	StatusReason                string `json:"status_reason,omitempty"`
+	SubmissionDatetime          string `json:"submission_datetime,omitempty"`
+}
+
+// SubmissionRelationships links a submission or an admission to its payment.
+type SubmissionRelationships struct {
+	Payment *Relationship `json:"payment,omitempty"`
+}
+
+// PaymentAdmissionRequest records the admission of an incoming payment.
+type PaymentAdmissionRequest struct {
+	Data *PaymentAdmissionData `json:"data,omitempty"`
+}
+
+type PaymentAdmissionResponse struct {
+	Data  *PaymentAdmissionData `json:"data,omitempty"`
+	Links *Links                `json:"links,omitempty"`
+}
+
+type PaymentAdmissionData struct {
+	Attributes     *PaymentAdmissionAttributes `json:"attributes,omitempty"`
+	ID             string                      `json:"id,omitempty"`
+	OrganisationID string                      `json:"organisation_id,omitempty"`
+	Relationships  *SubmissionRelationships    `json:"relationships,omitempty"`
+	Type           string                      `json:"type,omitempty"`
+	Version        *int64                      `json:"version,omitempty"`
+}
+
+type PaymentAdmissionAttributes struct {
+	AdmissionDatetime string `json:"admission_datetime,omitempty"`
+	SchemeStatusCode  string `json:"scheme_status_code,omitempty"`
+	SettlementCycle   *int   `json:"settlement_cycle,omitempty"`
+	SettlementDate    string `json:"settlement_date,omitempty"`
+	Status            string `json:"status,omitempty"`
+	StatusReason      string `json:"status_reason,omitempty"`
+}
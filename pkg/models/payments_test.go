@@ -0,0 +1,62 @@
+package models
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// Http client answering every request with the given body.
+type bodyHttpClient string
+
+func (c bodyHttpClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: 200, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(string(c)))}, nil
+}
+
+// Fetches the body into result through a client logging the bodies, and returns the logs.
+func logFetch(t *testing.T, body string, result interface{}) string {
+	var logs bytes.Buffer
+	logger := core.NewRequestLogger(slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	logger.LogBodies = true
+
+	client := &core.BaseClient{
+		BaseUrl:    url.URL{Scheme: "http", Host: "example.com"},
+		HttpClient: bodyHttpClient(body),
+		Timeout:    100,
+		Logger:     logger,
+	}
+
+	_, err := client.Send(core.NewRequestBuilder(http.MethodGet).WithResultWriteTo(result).Build())
+	assert.Nil(t, err)
+
+	return logs.String()
+}
+
+func TestPaymentPartyRedaction(t *testing.T) {
+	t.Run("Given a payment logged should redact the fields identifying its parties", func(t *testing.T) {
+		// Arrange
+		body := `{"data":{"id":"ad27e265-9605-4b4b-a0e5-3003ea9cc4dc","attributes":{"amount":"100.21",` +
+			`"debtor_party":{"account_name":"Samantha Holder","account_number":"41426819","address":["1 The Street"],"name":"Samantha Holder","bank_id":"400300"},` +
+			`"beneficiary_party":{"account_name":"Jane Payee","account_number":"71268996","address":["2 The Road"],"name":"Jane Payee","bank_id":"203301"}}}}`
+		result := &PaymentResponse{}
+
+		// Act
+		logs := logFetch(t, body, result)
+
+		// Assert
+		for _, value := range []string{"Samantha Holder", "41426819", "1 The Street", "Jane Payee", "71268996", "2 The Road"} {
+			assert.NotContains(t, logs, value)
+		}
+		assert.Contains(t, logs, `"account_number":"****6819"`)
+		assert.Contains(t, logs, `"bank_id":"400300"`)
+		assert.Contains(t, logs, `"amount":"100.21"`)
+		assert.Equal(t, "Samantha Holder", result.Data.Attributes.DebtorParty.Name)
+	})
+}
@@ -0,0 +1,24 @@
+package models
+
+// String returns a pointer to the given value, to fill optional string fields.
+func String(value string) *string {
+	return &value
+}
+
+// Bool returns a pointer to the given value, to fill optional bool fields.
+func Bool(value bool) *bool {
+	return &value
+}
+
+// Int64 returns a pointer to the given value, to fill optional int64 fields.
+func Int64(value int64) *int64 {
+	return &value
+}
+
+// Strings returns a pointer to the given values, to fill optional list fields.
+func Strings(values ...string) *[]string {
+	if values == nil {
+		values = []string{}
+	}
+	return &values
+}
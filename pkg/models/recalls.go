@@ -0,0 +1,151 @@
+package models
+
+// RecallReasonCode is the ISO 20022 reason for asking the beneficiary bank to send a payment back.
+type RecallReasonCode string
+
+const (
+	RecallDuplicatePayment       RecallReasonCode = "DUPL"
+	RecallTechnicalProblem       RecallReasonCode = "TECH"
+	RecallFraudulentOrigin       RecallReasonCode = "FRAD"
+	RecallRequestedByCustomer    RecallReasonCode = "CUST"
+	RecallWrongAmount            RecallReasonCode = "AM09"
+	RecallInvalidCreditorAccount RecallReasonCode = "AC03"
+)
+
+var recallReasonDescriptions = map[RecallReasonCode]string{
+	RecallDuplicatePayment:       "Duplicate payment",
+	RecallTechnicalProblem:       "Technical problem",
+	RecallFraudulentOrigin:       "Fraudulent origin",
+	RecallRequestedByCustomer:    "Requested by the customer",
+	RecallWrongAmount:            "Wrong amount",
+	RecallInvalidCreditorAccount: "Invalid creditor account number",
+}
+
+// Description returns a human readable description of the code, or an empty string when the code is unknown.
+func (c RecallReasonCode) Description() string {
+	return recallReasonDescriptions[c]
+}
+
+// IsKnown reports whether the code is one of the codes declared in this package.
+func (c RecallReasonCode) IsKnown() bool {
+	_, ok := recallReasonDescriptions[c]
+	return ok
+}
+
+// RecallAnswer is the decision taken on a received recall.
+type RecallAnswer string
+
+const (
+	RecallAccepted RecallAnswer = "accepted"
+	RecallRejected RecallAnswer = "rejected"
+)
+
+// RecallRejectionCode is the ISO 20022 reason for rejecting a recall.
+type RecallRejectionCode string
+
+const (
+	RecallRejectedClosedAccount        RecallRejectionCode = "AC04"
+	RecallRejectedInsufficientFunds    RecallRejectionCode = "AM04"
+	RecallRejectedAlreadyReturned      RecallRejectionCode = "ARDT"
+	RecallRejectedByCustomer           RecallRejectionCode = "CUST"
+	RecallRejectedLegalDecision        RecallRejectionCode = "LEGL"
+	RecallRejectedNoAnswerFromCustomer RecallRejectionCode = "NOAS"
+	RecallRejectedNoOriginalPayment    RecallRejectionCode = "NOOR"
+)
+
+var recallRejectionDescriptions = map[RecallRejectionCode]string{
+	RecallRejectedClosedAccount:        "Account closed",
+	RecallRejectedInsufficientFunds:    "Insufficient funds",
+	RecallRejectedAlreadyReturned:      "Payment already returned",
+	RecallRejectedByCustomer:           "Rejected by the customer",
+	RecallRejectedLegalDecision:        "Legal decision",
+	RecallRejectedNoAnswerFromCustomer: "No answer from the customer",
+	RecallRejectedNoOriginalPayment:    "Original payment never received",
+}
+
+// Description returns a human readable description of the code, or an empty string when the code is unknown.
+func (c RecallRejectionCode) Description() string {
+	return recallRejectionDescriptions[c]
+}
+
+// IsKnown reports whether the code is one of the codes declared in this package.
+func (c RecallRejectionCode) IsKnown() bool {
+	_, ok := recallRejectionDescriptions[c]
+	return ok
+}
+
+// RecallRequest asks the beneficiary bank to send a payment back.
+type RecallRequest struct {
+	Data *RecallData `json:"data,omitempty"`
+}
+
+type RecallResponse struct {
+	Data  *RecallData `json:"data,omitempty"`
+	Links *Links      `json:"links,omitempty"`
+}
+
+type RecallData struct {
+	Attributes     *RecallAttributes        `json:"attributes,omitempty"`
+	ID             string                   `json:"id,omitempty"`
+	OrganisationID string                   `json:"organisation_id,omitempty"`
+	Relationships  *SubmissionRelationships `json:"relationships,omitempty"`
+	Type           string                   `json:"type,omitempty"`
+	Version        *int64                   `json:"version,omitempty"`
+}
+
+type RecallAttributes struct {
+	Reason            RecallReasonCode `json:"reason,omitempty"`
+	ReasonDescription string           `json:"reason_description,omitempty"`
+}
+
+// RecallDecisionRequest answers a received recall.
+type RecallDecisionRequest struct {
+	Data *RecallDecisionData `json:"data,omitempty"`
+}
+
+type RecallDecisionResponse struct {
+	Data  *RecallDecisionData `json:"data,omitempty"`
+	Links *Links              `json:"links,omitempty"`
+}
+
+type RecallDecisionData struct {
+	Attributes     *RecallDecisionAttributes    `json:"attributes,omitempty"`
+	ID             string                       `json:"id,omitempty"`
+	OrganisationID string                       `json:"organisation_id,omitempty"`
+	Relationships  *RecallDecisionRelationships `json:"relationships,omitempty"`
+	Type           string                       `json:"type,omitempty"`
+	Version        *int64                       `json:"version,omitempty"`
+}
+
+// RecallDecisionAttributes holds the answer to a recall. The reject reason is only set when the recall is rejected.
+type RecallDecisionAttributes struct {
+	Answer       RecallAnswer        `json:"answer,omitempty"`
+	RejectReason RecallRejectionCode `json:"reject_reason,omitempty"`
+}
+
+type RecallDecisionRelationships struct {
+	Recall *Relationship `json:"recall,omitempty"`
+}
+
+// RecallDecisionSubmissionRequest asks for a recall decision to be submitted to the payment scheme.
+type RecallDecisionSubmissionRequest struct {
+	Data *RecallDecisionSubmissionData `json:"data,omitempty"`
+}
+
+type RecallDecisionSubmissionResponse struct {
+	Data  *RecallDecisionSubmissionData `json:"data,omitempty"`
+	Links *Links                        `json:"links,omitempty"`
+}
+
+type RecallDecisionSubmissionData struct {
+	Attributes     *PaymentSubmissionAttributes           `json:"attributes,omitempty"`
+	ID             string                                 `json:"id,omitempty"`
+	OrganisationID string                                 `json:"organisation_id,omitempty"`
+	Relationships  *RecallDecisionSubmissionRelationships `json:"relationships,omitempty"`
+	Type           string                                 `json:"type,omitempty"`
+	Version        *int64                                 `json:"version,omitempty"`
+}
+
+type RecallDecisionSubmissionRelationships struct {
+	RecallDecision *Relationship `json:"recall_decision,omitempty"`
+}
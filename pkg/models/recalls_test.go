@@ -0,0 +1,36 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecallReasonCodes(t *testing.T) {
+	t.Run("Given a declared reason code should describe it", func(t *testing.T) {
+		// Act & Assert
+		assert.True(t, RecallFraudulentOrigin.IsKnown())
+		assert.Equal(t, "Fraudulent origin", RecallFraudulentOrigin.Description())
+		assert.True(t, RecallRejectedNoOriginalPayment.IsKnown())
+		assert.NotEmpty(t, RecallRejectedNoOriginalPayment.Description())
+	})
+
+	t.Run("Given an unknown reason code should not describe it", func(t *testing.T) {
+		// Act & Assert
+		assert.False(t, RecallReasonCode("XXXX").IsKnown())
+		assert.Empty(t, RecallReasonCode("XXXX").Description())
+	})
+
+	t.Run("Given a recall decision should marshal its answer and reject reason codes", func(t *testing.T) {
+		// Arrange
+		decision := &RecallDecisionAttributes{Answer: RecallRejected, RejectReason: RecallRejectedByCustomer}
+
+		// Act
+		actual, err := json.Marshal(decision)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"answer":"rejected","reject_reason":"CUST"}`, string(actual))
+	})
+}
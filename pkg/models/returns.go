@@ -0,0 +1,91 @@
+package models
+
+// ReturnReasonCode is the ISO 20022 reason for returning a payment to its debtor.
+type ReturnReasonCode string
+
+const (
+	ReturnIncorrectAccountNumber ReturnReasonCode = "AC01"
+	ReturnClosedAccount          ReturnReasonCode = "AC04"
+	ReturnBlockedAccount         ReturnReasonCode = "AC06"
+	ReturnTransactionForbidden   ReturnReasonCode = "AG01"
+	ReturnWrongAmount            ReturnReasonCode = "AM09"
+	ReturnMissingCreditorAddress ReturnReasonCode = "BE04"
+	ReturnCreditorDeceased       ReturnReasonCode = "MD07"
+	ReturnNotSpecifiedByAgent    ReturnReasonCode = "MS03"
+	ReturnRegulatoryReason       ReturnReasonCode = "RR04"
+	ReturnFollowingCancellation  ReturnReasonCode = "FOCR"
+	ReturnRequestedByCustomer    ReturnReasonCode = "CUST"
+)
+
+var returnReasonDescriptions = map[ReturnReasonCode]string{
+	ReturnIncorrectAccountNumber: "Account number incorrect",
+	ReturnClosedAccount:          "Account closed",
+	ReturnBlockedAccount:         "Account blocked",
+	ReturnTransactionForbidden:   "Transaction forbidden on this type of account",
+	ReturnWrongAmount:            "Wrong amount",
+	ReturnMissingCreditorAddress: "Missing creditor address",
+	ReturnCreditorDeceased:       "Creditor deceased",
+	ReturnNotSpecifiedByAgent:    "Reason not specified, generated by the agent",
+	ReturnRegulatoryReason:       "Regulatory reason",
+	ReturnFollowingCancellation:  "Return following a cancellation request",
+	ReturnRequestedByCustomer:    "Requested by the customer",
+}
+
+// Description returns a human readable description of the code, or an empty string when the code is unknown.
+func (c ReturnReasonCode) Description() string {
+	return returnReasonDescriptions[c]
+}
+
+// IsKnown reports whether the code is one of the codes declared in this package.
+func (c ReturnReasonCode) IsKnown() bool {
+	_, ok := returnReasonDescriptions[c]
+	return ok
+}
+
+// ReturnRequest asks for a received payment to be returned to its debtor.
+type ReturnRequest struct {
+	Data *ReturnData `json:"data,omitempty"`
+}
+
+type ReturnResponse struct {
+	Data  *ReturnData `json:"data,omitempty"`
+	Links *Links      `json:"links,omitempty"`
+}
+
+type ReturnData struct {
+	Attributes     *ReturnAttributes        `json:"attributes,omitempty"`
+	ID             string                   `json:"id,omitempty"`
+	OrganisationID string                   `json:"organisation_id,omitempty"`
+	Relationships  *SubmissionRelationships `json:"relationships,omitempty"`
+	Type           string                   `json:"type,omitempty"`
+	Version        *int64                   `json:"version,omitempty"`
+}
+
+type ReturnAttributes struct {
+	Amount     string           `json:"amount,omitempty"`
+	Currency   string           `json:"currency,omitempty"`
+	ReturnCode ReturnReasonCode `json:"return_code,omitempty"`
+}
+
+// ReturnSubmissionRequest asks for a return to be submitted to the payment scheme.
+type ReturnSubmissionRequest struct {
+	Data *ReturnSubmissionData `json:"data,omitempty"`
+}
+
+type ReturnSubmissionResponse struct {
+	Data  *ReturnSubmissionData `json:"data,omitempty"`
+	Links *Links                `json:"links,omitempty"`
+}
+
+type ReturnSubmissionData struct {
+	Attributes     *PaymentSubmissionAttributes   `json:"attributes,omitempty"`
+	ID             string                         `json:"id,omitempty"`
+	OrganisationID string                         `json:"organisation_id,omitempty"`
+	Relationships  *ReturnSubmissionRelationships `json:"relationships,omitempty"`
+	Type           string                         `json:"type,omitempty"`
+	Version        *int64                         `json:"version,omitempty"`
+}
+
+type ReturnSubmissionRelationships struct {
+	PaymentReturn *Relationship `json:"payment_return,omitempty"`
+}
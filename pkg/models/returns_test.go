@@ -0,0 +1,35 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnReasonCodes(t *testing.T) {
+	t.Run("Given a declared reason code should describe it", func(t *testing.T) {
+		// Act & Assert
+		assert.True(t, ReturnClosedAccount.IsKnown())
+		assert.Equal(t, "Account closed", ReturnClosedAccount.Description())
+	})
+
+	t.Run("Given an unknown reason code should not describe it", func(t *testing.T) {
+		// Act & Assert
+		assert.False(t, ReturnReasonCode("XXXX").IsKnown())
+		assert.Empty(t, ReturnReasonCode("XXXX").Description())
+	})
+
+	t.Run("Given a return with an unknown reason code should unmarshal it as is", func(t *testing.T) {
+		// Arrange
+		var attributes ReturnAttributes
+
+		// Act
+		err := json.Unmarshal([]byte(`{"return_code":"ZZ99","amount":"10.00"}`), &attributes)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, ReturnReasonCode("ZZ99"), attributes.ReturnCode)
+		assert.False(t, attributes.ReturnCode.IsKnown())
+	})
+}
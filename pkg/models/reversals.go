@@ -0,0 +1,73 @@
+package models
+
+// ReversalReasonCode is the ISO 20022 reason for reversing a payment that was already settled.
+type ReversalReasonCode string
+
+const (
+	ReversalDuplication            ReversalReasonCode = "AM05"
+	ReversalNotSpecifiedByCustomer ReversalReasonCode = "MS02"
+	ReversalNotSpecifiedByAgent    ReversalReasonCode = "MS03"
+)
+
+var reversalReasonDescriptions = map[ReversalReasonCode]string{
+	ReversalDuplication:            "Duplicate payment",
+	ReversalNotSpecifiedByCustomer: "Reason not specified, generated by the customer",
+	ReversalNotSpecifiedByAgent:    "Reason not specified, generated by the agent",
+}
+
+// Description returns a human readable description of the code, or an empty string when the code is unknown.
+func (c ReversalReasonCode) Description() string {
+	return reversalReasonDescriptions[c]
+}
+
+// IsKnown reports whether the code is one of the codes declared in this package.
+func (c ReversalReasonCode) IsKnown() bool {
+	_, ok := reversalReasonDescriptions[c]
+	return ok
+}
+
+// ReversalRequest asks for a sent payment to be reversed.
+type ReversalRequest struct {
+	Data *ReversalData `json:"data,omitempty"`
+}
+
+type ReversalResponse struct {
+	Data  *ReversalData `json:"data,omitempty"`
+	Links *Links        `json:"links,omitempty"`
+}
+
+type ReversalData struct {
+	Attributes     *ReversalAttributes      `json:"attributes,omitempty"`
+	ID             string                   `json:"id,omitempty"`
+	OrganisationID string                   `json:"organisation_id,omitempty"`
+	Relationships  *SubmissionRelationships `json:"relationships,omitempty"`
+	Type           string                   `json:"type,omitempty"`
+	Version        *int64                   `json:"version,omitempty"`
+}
+
+type ReversalAttributes struct {
+	ReversalCode ReversalReasonCode `json:"reversal_code,omitempty"`
+}
+
+// ReversalSubmissionRequest asks for a reversal to be submitted to the payment scheme.
+type ReversalSubmissionRequest struct {
+	Data *ReversalSubmissionData `json:"data,omitempty"`
+}
+
+type ReversalSubmissionResponse struct {
+	Data  *ReversalSubmissionData `json:"data,omitempty"`
+	Links *Links                  `json:"links,omitempty"`
+}
+
+type ReversalSubmissionData struct {
+	Attributes     *PaymentSubmissionAttributes     `json:"attributes,omitempty"`
+	ID             string                           `json:"id,omitempty"`
+	OrganisationID string                           `json:"organisation_id,omitempty"`
+	Relationships  *ReversalSubmissionRelationships `json:"relationships,omitempty"`
+	Type           string                           `json:"type,omitempty"`
+	Version        *int64                           `json:"version,omitempty"`
+}
+
+type ReversalSubmissionRelationships struct {
+	PaymentReversal *Relationship `json:"payment_reversal,omitempty"`
+}
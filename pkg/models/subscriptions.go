@@ -0,0 +1,62 @@
+package models
+
+// Transports used to deliver notifications to a subscription callback.
+const (
+	CallbackTransportHTTP string = "http"
+	CallbackTransportSQS  string = "sqs"
+)
+
+// SubscriptionRequest registers a callback to be notified about events on a record type.
+type SubscriptionRequest struct {
+	Data *SubscriptionData `json:"data,omitempty"`
+}
+
+type SubscriptionResponse struct {
+	Data  *SubscriptionData `json:"data,omitempty"`
+	Links *Links            `json:"links,omitempty"`
+}
+
+type SubscriptionListResponse struct {
+	Data  []*SubscriptionData `json:"data,omitempty"`
+	Links *Links              `json:"links,omitempty"`
+}
+
+type SubscriptionData struct {
+	Attributes     *SubscriptionAttributes `json:"attributes,omitempty"`
+	ID             string                  `json:"id,omitempty"`
+	OrganisationID string                  `json:"organisation_id,omitempty"`
+	Type           string                  `json:"type,omitempty"`
+	Version        *int64                  `json:"version,omitempty"`
+}
+
+// SubscriptionAttributes describes which events are sent where: events of EventType (e.g. created)
+// on records of RecordType (e.g. accounts) are delivered to CallbackURI.
+type SubscriptionAttributes struct {
+	CallbackTransport string `json:"callback_transport,omitempty"`
+	CallbackURI       string `json:"callback_uri,omitempty"`
+	Deactivated       *bool  `json:"deactivated,omitempty"`
+	EventType         string `json:"event_type,omitempty"`
+	RecordType        string `json:"record_type,omitempty"`
+	UserID            string `json:"user_id,omitempty"`
+}
+
+// SubscriptionPatchRequest is the body of a subscription update.
+type SubscriptionPatchRequest struct {
+	Data *SubscriptionPatchData `json:"data,omitempty"`
+}
+
+type SubscriptionPatchData struct {
+	Attributes *SubscriptionPatchAttributes `json:"attributes,omitempty"`
+	ID         string                       `json:"id,omitempty"`
+	Type       string                       `json:"type,omitempty"`
+	Version    *int64                       `json:"version,omitempty"`
+}
+
+// SubscriptionPatchAttributes holds the attributes to change in a subscription update. Nil fields are left untouched.
+type SubscriptionPatchAttributes struct {
+	CallbackTransport *string `json:"callback_transport,omitempty"`
+	CallbackURI       *string `json:"callback_uri,omitempty"`
+	Deactivated       *bool   `json:"deactivated,omitempty"`
+	EventType         *string `json:"event_type,omitempty"`
+	RecordType        *string `json:"record_type,omitempty"`
+}
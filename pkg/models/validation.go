@@ -0,0 +1,164 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// FieldError describes why a single field of a request is invalid.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s %s", e.Field, e.Message)
+}
+
+// ValidationErrors holds every field error found while validating a request.
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fieldError := range e {
+		messages = append(messages, fieldError.Error())
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+// Field returns the error of the given field, or nil when the field is valid.
+func (e ValidationErrors) Field(field string) *FieldError {
+	for _, fieldError := range e {
+		if fieldError.Field == field {
+			return fieldError
+		}
+	}
+	return nil
+}
+
+const (
+	fieldCountry               string = "data.attributes.country"
+	fieldBankID                string = "data.attributes.bank_id"
+	fieldBankIDCode            string = "data.attributes.bank_id_code"
+	fieldBic                   string = "data.attributes.bic"
+	fieldAccountNumber         string = "data.attributes.account_number"
+	fieldIban                  string = "data.attributes.iban"
+	fieldAccountClassification string = "data.attributes.account_classification"
+)
+
+// countryRule holds the account scheme rules of a country.
+type countryRule struct {
+	// bankID is the expected bank id format, nil when the country does not support a bank id
+	bankID         *regexp.Regexp
+	bankIDRequired bool
+	// bankIDCode is the required bank id code, empty when the country does not support one
+	bankIDCode    string
+	bicRequired   bool
+	accountNumber *regexp.Regexp
+	ibanAllowed   bool
+}
+
+var countryRules = map[string]countryRule{
+	"GB": {bankID: regexp.MustCompile(`^[0-9]{6}$`), bankIDRequired: true, bankIDCode: "GBDSC", bicRequired: true, accountNumber: regexp.MustCompile(`^[0-9]{8}$`), ibanAllowed: true},
+	"AU": {bankID: regexp.MustCompile(`^[0-9]{6}$`), bankIDCode: "AUBSB", bicRequired: true, accountNumber: regexp.MustCompile(`^[1-9][0-9]{5,9}$`)},
+	"BE": {bankID: regexp.MustCompile(`^[0-9]{3}$`), bankIDRequired: true, bankIDCode: "BE", accountNumber: regexp.MustCompile(`^[0-9]{7}$`), ibanAllowed: true},
+	"CA": {bankID: regexp.MustCompile(`^0[0-9]{8}$`), bankIDCode: "CACPA", bicRequired: true, accountNumber: regexp.MustCompile(`^[0-9]{7,12}$`)},
+	"FR": {bankID: regexp.MustCompile(`^[0-9]{10}$`), bankIDRequired: true, bankIDCode: "FR", accountNumber: regexp.MustCompile(`^[0-9A-Z]{10}$`), ibanAllowed: true},
+	"DE": {bankID: regexp.MustCompile(`^[0-9]{8}$`), bankIDRequired: true, bankIDCode: "DEBLZ", accountNumber: regexp.MustCompile(`^[0-9]{7}$`), ibanAllowed: true},
+	"GR": {bankID: regexp.MustCompile(`^[0-9]{7}$`), bankIDRequired: true, bankIDCode: "GRBIC", accountNumber: regexp.MustCompile(`^[0-9]{16}$`), ibanAllowed: true},
+	"HK": {bankID: regexp.MustCompile(`^[0-9]{3}$`), bankIDCode: "HKNCC", bicRequired: true, accountNumber: regexp.MustCompile(`^[0-9]{9,12}$`)},
+	"IT": {bankID: regexp.MustCompile(`^[0-9]{10,11}$`), bankIDRequired: true, bankIDCode: "ITNCC", accountNumber: regexp.MustCompile(`^[0-9A-Z]{12}$`), ibanAllowed: true},
+	"LU": {bankID: regexp.MustCompile(`^[0-9]{3}$`), bankIDRequired: true, bankIDCode: "LULUX", accountNumber: regexp.MustCompile(`^[0-9A-Z]{13}$`), ibanAllowed: true},
+	"NL": {bicRequired: true, accountNumber: regexp.MustCompile(`^[0-9]{10}$`), ibanAllowed: true},
+	"PL": {bankID: regexp.MustCompile(`^[0-9]{8}$`), bankIDRequired: true, bankIDCode: "PLKNR", accountNumber: regexp.MustCompile(`^[0-9]{16}$`), ibanAllowed: true},
+	"PT": {bankID: regexp.MustCompile(`^[0-9]{8}$`), bankIDRequired: true, bankIDCode: "PTNCC", accountNumber: regexp.MustCompile(`^[0-9]{11}$`), ibanAllowed: true},
+	"ES": {bankID: regexp.MustCompile(`^[0-9]{8}$`), bankIDRequired: true, bankIDCode: "ESNCC", accountNumber: regexp.MustCompile(`^[0-9]{10}$`), ibanAllowed: true},
+	"CH": {bankID: regexp.MustCompile(`^[0-9]{5}$`), bankIDRequired: true, bankIDCode: "CHBCC", accountNumber: regexp.MustCompile(`^[0-9A-Z]{12}$`), ibanAllowed: true},
+	"US": {bankID: regexp.MustCompile(`^[0-9]{9}$`), bankIDRequired: true, bankIDCode: "USABA", bicRequired: true, accountNumber: regexp.MustCompile(`^[0-9]{6,17}$`)},
+}
+
+// Validate checks the account attributes against the rules of the account country scheme
+// and returns ValidationErrors listing every invalid field, or nil when the request is valid.
+// Countries without known rules only get the generic checks.
+func (r *AccountRequest) Validate() error {
+	if r == nil || r.Data == nil || r.Data.Attributes == nil {
+		return ValidationErrors{{Field: "data.attributes", Message: "is required"}}
+	}
+
+	attributes := r.Data.Attributes
+	var errs ValidationErrors
+
+	add := func(field string, format string, args ...interface{}) {
+		errs = append(errs, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if attributes.AccountClassification != nil && *attributes.AccountClassification != "Personal" && *attributes.AccountClassification != "Business" {
+		add(fieldAccountClassification, "must be Personal or Business")
+	}
+
+	if attributes.Bic != "" {
+		if bic, err := ParseBIC(attributes.Bic); err != nil || bic.String() != attributes.Bic {
+			add(fieldBic, "must have 8 or 11 characters in the BIC format")
+		}
+	}
+
+	if attributes.Country == nil || *attributes.Country == "" {
+		add(fieldCountry, "is required")
+		return errs.orNil()
+	}
+
+	country := *attributes.Country
+	rule, known := countryRules[country]
+	if !known {
+		return errs.orNil()
+	}
+
+	switch {
+	case rule.bankID == nil && attributes.BankID != "":
+		add(fieldBankID, "is not supported for %s", country)
+	case rule.bankIDRequired && attributes.BankID == "":
+		add(fieldBankID, "is required for %s", country)
+	case rule.bankID != nil && attributes.BankID != "" && !rule.bankID.MatchString(attributes.BankID):
+		add(fieldBankID, "must match %s for %s", rule.bankID, country)
+	}
+
+	switch {
+	case rule.bankIDCode == "" && attributes.BankIDCode != "":
+		add(fieldBankIDCode, "is not supported for %s", country)
+	case rule.bankIDCode != "" && attributes.BankIDCode != rule.bankIDCode:
+		add(fieldBankIDCode, "must be %s for %s", rule.bankIDCode, country)
+	}
+
+	if rule.bicRequired && attributes.Bic == "" {
+		add(fieldBic, "is required for %s", country)
+	}
+
+	if attributes.AccountNumber != "" && !rule.accountNumber.MatchString(attributes.AccountNumber) {
+		add(fieldAccountNumber, "must match %s for %s", rule.accountNumber, country)
+	}
+
+	switch {
+	case attributes.Iban == "":
+	case !rule.ibanAllowed:
+		add(fieldIban, "is not supported for %s", country)
+	default:
+		iban, err := ParseIBAN(attributes.Iban)
+		switch {
+		case err != nil || iban.String() != attributes.Iban:
+			add(fieldIban, "must be a valid IBAN without spaces")
+		case iban.CountryCode() != country:
+			add(fieldIban, "must be a %s IBAN", country)
+		}
+	}
+
+	return errs.orNil()
+}
+
+// Returns nil when there are no errors, so that callers can compare the result against nil.
+func (e ValidationErrors) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
@@ -0,0 +1,134 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAccountRequest(country, bankID, bankIDCode, bic, accountNumber, iban string) *AccountRequest {
+	return &AccountRequest{
+		Data: &AccountData{
+			Attributes: &AccountAttributes{
+				AccountNumber: accountNumber,
+				BankID:        bankID,
+				BankIDCode:    bankIDCode,
+				Bic:           bic,
+				Country:       String(country),
+				Iban:          iban,
+				Name:          []string{"Daniel"},
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	validRequests := []*AccountRequest{
+		newAccountRequest("GB", "400300", "GBDSC", "NWBKGB22", "41426819", "GB16NWBK40030041426819"),
+		newAccountRequest("AU", "", "AUBSB", "NWBKAU22", "1234567", ""),
+		newAccountRequest("BE", "123", "BE", "", "1234567", ""),
+		newAccountRequest("CA", "012345678", "CACPA", "NWBKCA22", "1234567", ""),
+		newAccountRequest("FR", "1234512345", "FR", "", "0123456789", ""),
+		newAccountRequest("DE", "12345678", "DEBLZ", "", "1234567", ""),
+		newAccountRequest("GR", "1234567", "GRBIC", "", "0123456789012345", ""),
+		newAccountRequest("HK", "", "HKNCC", "NWBKHK22", "123456789", ""),
+		newAccountRequest("IT", "1234567890", "ITNCC", "", "012345678901", ""),
+		newAccountRequest("LU", "123", "LULUX", "", "0123456789012", ""),
+		newAccountRequest("NL", "", "", "NWBKNL22", "0123456789", ""),
+		newAccountRequest("PL", "12345678", "PLKNR", "", "0123456789012345", ""),
+		newAccountRequest("PT", "12345678", "PTNCC", "", "01234567890", ""),
+		newAccountRequest("ES", "12345678", "ESNCC", "", "0123456789", ""),
+		newAccountRequest("CH", "12345", "CHBCC", "", "012345678901", ""),
+		newAccountRequest("US", "123456789", "USABA", "NWBKUS22", "123456", ""),
+	}
+
+	for _, request := range validRequests {
+		t.Run("Given a valid account for the country should not return errors", func(t *testing.T) {
+			// Act
+			err := request.Validate()
+
+			// Assert
+			assert.Nil(t, err, *request.Data.Attributes.Country)
+		})
+	}
+
+	testCases := []struct {
+		description string
+		request     *AccountRequest
+		fields      []string
+	}{
+		{"GB without bank id code, sort code and BIC",
+			newAccountRequest("GB", "", "", "", "", ""),
+			[]string{fieldBankID, fieldBankIDCode, fieldBic}},
+		{"GB with a 5 digit sort code and a wrong bank id code",
+			newAccountRequest("GB", "40030", "GBXXX", "NWBKGB22", "", ""),
+			[]string{fieldBankID, fieldBankIDCode}},
+		{"AU with an IBAN and an account number starting with 0",
+			newAccountRequest("AU", "", "AUBSB", "NWBKAU22", "0123456", "AU0000"),
+			[]string{fieldAccountNumber, fieldIban}},
+		{"NL with a bank id and bank id code",
+			newAccountRequest("NL", "123", "NLXXX", "NWBKNL22", "", ""),
+			[]string{fieldBankID, fieldBankIDCode}},
+		{"US with an invalid BIC and an IBAN",
+			newAccountRequest("US", "123456789", "USABA", "NWBK", "", "US0000"),
+			[]string{fieldBic, fieldIban}},
+		{"GB with an IBAN with a wrong checksum",
+			newAccountRequest("GB", "400300", "GBDSC", "NWBKGB22", "41426819", "GB11NWBK40030041426819"),
+			[]string{fieldIban}},
+		{"DE with a GB IBAN and a lowercase BIC",
+			newAccountRequest("DE", "12345678", "DEBLZ", "nwbkde22", "1234567", "GB16NWBK40030041426819"),
+			[]string{fieldIban, fieldBic}},
+		{"no country",
+			newAccountRequest("", "", "", "", "", ""),
+			[]string{fieldCountry}},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given an invalid account should return an error for each invalid field: "+tc.description, func(t *testing.T) {
+			// Act
+			err := tc.request.Validate()
+
+			// Assert
+			var validationErrors ValidationErrors
+			assert.True(t, errors.As(err, &validationErrors))
+
+			var fields []string
+			for _, fieldError := range validationErrors {
+				fields = append(fields, fieldError.Field)
+			}
+			assert.ElementsMatch(t, tc.fields, fields)
+		})
+	}
+
+	t.Run("Given an invalid account classification should return an error for it", func(t *testing.T) {
+		// Arrange
+		request := newAccountRequest("XX", "", "", "", "", "")
+		request.Data.Attributes.AccountClassification = String("Other")
+
+		// Act
+		err := request.Validate()
+
+		// Assert
+		assert.NotNil(t, err.(ValidationErrors).Field(fieldAccountClassification))
+		assert.Nil(t, err.(ValidationErrors).Field(fieldCountry))
+	})
+
+	t.Run("Given no attributes should return an error", func(t *testing.T) {
+		// Act
+		err := (&AccountRequest{}).Validate()
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("Given a nil request should return an error", func(t *testing.T) {
+		// Arrange
+		var request *AccountRequest
+
+		// Act
+		err := request.Validate()
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+// Package organisations provides the client of the organisations and organisation units API.
+// Organisations own the accounts and the other resources through their OrganisationID, and are
+// organised in a hierarchy: organisations can have a parent organisation, and units are nested
+// under a parent unit or directly under their organisation.
+package organisations
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	baseOrganisationsPath string = "/v1/organisation/organisations"
+	organisationsType     string = "organisations"
+)
+
+type OrganisationsClient struct {
+	baseClient core.Client
+	Units      *UnitsClient
+}
+
+func New(baseClient core.Client) *OrganisationsClient {
+	oc := &OrganisationsClient{
+		baseClient: baseClient,
+	}
+	oc.Units = &UnitsClient{organisations: oc}
+
+	return oc
+}
+
+func (oc *OrganisationsClient) Fetch(ctx context.Context, id uuid.UUID) (*models.OrganisationResponse, error) {
+	organisationResponse := &models.OrganisationResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseOrganisationsPath).
+		WithPath(id.String())
+
+	if err := core.SendExpecting(ctx, oc.baseClient, builder, http.StatusOK, organisationResponse); err != nil {
+		return nil, err
+	}
+
+	return organisationResponse, nil
+}
+
+// Create creates the organisation, under the parent set in its relationships if any.
+func (oc *OrganisationsClient) Create(ctx context.Context, organisation *models.OrganisationRequest) (*models.OrganisationResponse, error) {
+	organisationResponse := &models.OrganisationResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseOrganisationsPath).
+		WithBody(organisation)
+
+	if err := core.CreateRecord(ctx, oc.baseClient, builder, organisationResponse); err != nil {
+		return nil, err
+	}
+
+	return organisationResponse, nil
+}
+
+// ListOptions holds the paging and filtering parameters used when listing organisations.
+type ListOptions struct {
+	core.PageOptions
+	Filter ListFilter
+}
+
+// ListFilter restricts the organisations returned by List. Every field accepts multiple values.
+type ListFilter struct {
+	Name     []string
+	Country  []string
+	ParentID []string
+}
+
+// List returns a single page of organisations together with the links to the surrounding pages.
+func (oc *OrganisationsClient) List(ctx context.Context, opts *ListOptions) (*models.OrganisationListResponse, error) {
+	listResponse := &models.OrganisationListResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseOrganisationsPath)
+
+	if opts != nil {
+		builder = opts.apply(builder)
+	}
+
+	if err := core.SendExpecting(ctx, oc.baseClient, builder, http.StatusOK, listResponse); err != nil {
+		return nil, err
+	}
+
+	return listResponse, nil
+}
+
+// Children returns a single page of the organisations whose parent is the organisation of the given id.
+// Any parent filter in opts is replaced.
+func (oc *OrganisationsClient) Children(ctx context.Context, id uuid.UUID, opts *ListOptions) (*models.OrganisationListResponse, error) {
+	childOpts := ListOptions{}
+	if opts != nil {
+		childOpts = *opts
+	}
+	childOpts.Filter.ParentID = []string{id.String()}
+
+	return oc.List(ctx, &childOpts)
+}
+
+// Update changes the given attributes of the organisation, provided its current version matches version.
+// A version mismatch fails with a *core.ConflictError.
+func (oc *OrganisationsClient) Update(ctx context.Context, id uuid.UUID, version int64, patch *models.OrganisationPatchAttributes) (*models.OrganisationResponse, error) {
+	organisationResponse := &models.OrganisationResponse{}
+
+	body := &models.OrganisationPatchRequest{
+		Data: &models.OrganisationPatchData{
+			Attributes: patch,
+			ID:         id.String(),
+			Type:       organisationsType,
+			Version:    &version,
+		},
+	}
+
+	builder := core.NewRequestBuilder(http.MethodPatch).
+		WithPath(baseOrganisationsPath).
+		WithPath(id.String()).
+		WithBody(body)
+
+	if err := core.SendExpecting(ctx, oc.baseClient, builder, http.StatusOK, organisationResponse); err != nil {
+		return nil, err
+	}
+
+	return organisationResponse, nil
+}
+
+// Delete deletes the organisation. The API refuses with a *core.ConflictError to delete an organisation
+// that still has children, units or accounts.
+func (oc *OrganisationsClient) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	builder := core.NewRequestBuilder(http.MethodDelete).
+		WithPath(baseOrganisationsPath).
+		WithPath(id.String()).
+		WithQueryParam("version", strconv.FormatInt(version, 10))
+
+	return core.SendExpecting(ctx, oc.baseClient, builder, http.StatusNoContent, nil)
+}
+
+func (opts *ListOptions) apply(builder core.RequestBuilder) core.RequestBuilder {
+	return core.ApplyList(builder, opts.PageOptions, core.ListFilters{
+		"filter[name]":      opts.Filter.Name,
+		"filter[country]":   opts.Filter.Country,
+		"filter[parent_id]": opts.Filter.ParentID,
+	})
+}
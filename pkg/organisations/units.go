@@ -0,0 +1,137 @@
+package organisations
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	baseUnitsPath string = "/v1/organisation/units"
+	unitsType     string = "organisation_units"
+)
+
+// UnitsClient handles the units of the organisations, under /v1/organisation/units.
+type UnitsClient struct {
+	organisations *OrganisationsClient
+}
+
+func (uc *UnitsClient) Fetch(ctx context.Context, id uuid.UUID) (*models.OrganisationUnitResponse, error) {
+	unitResponse := &models.OrganisationUnitResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseUnitsPath).
+		WithPath(id.String())
+
+	if err := core.SendExpecting(ctx, uc.organisations.baseClient, builder, http.StatusOK, unitResponse); err != nil {
+		return nil, err
+	}
+
+	return unitResponse, nil
+}
+
+// Create creates the unit under its organisation, nested under the parent unit set in its relationships if any.
+func (uc *UnitsClient) Create(ctx context.Context, unit *models.OrganisationUnitRequest) (*models.OrganisationUnitResponse, error) {
+	unitResponse := &models.OrganisationUnitResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseUnitsPath).
+		WithBody(unit)
+
+	if err := core.CreateRecord(ctx, uc.organisations.baseClient, builder, unitResponse); err != nil {
+		return nil, err
+	}
+
+	return unitResponse, nil
+}
+
+// UnitListOptions holds the paging and filtering parameters used when listing organisation units.
+type UnitListOptions struct {
+	core.PageOptions
+	Filter UnitListFilter
+}
+
+// UnitListFilter restricts the units returned by List. Every field accepts multiple values.
+type UnitListFilter struct {
+	OrganisationID []string
+	Name           []string
+	ParentID       []string
+}
+
+// List returns a single page of organisation units together with the links to the surrounding pages.
+func (uc *UnitsClient) List(ctx context.Context, opts *UnitListOptions) (*models.OrganisationUnitListResponse, error) {
+	listResponse := &models.OrganisationUnitListResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseUnitsPath)
+
+	if opts != nil {
+		builder = opts.apply(builder)
+	}
+
+	if err := core.SendExpecting(ctx, uc.organisations.baseClient, builder, http.StatusOK, listResponse); err != nil {
+		return nil, err
+	}
+
+	return listResponse, nil
+}
+
+// Children returns a single page of the units nested under the unit of the given id.
+// Any parent filter in opts is replaced.
+func (uc *UnitsClient) Children(ctx context.Context, id uuid.UUID, opts *UnitListOptions) (*models.OrganisationUnitListResponse, error) {
+	childOpts := UnitListOptions{}
+	if opts != nil {
+		childOpts = *opts
+	}
+	childOpts.Filter.ParentID = []string{id.String()}
+
+	return uc.List(ctx, &childOpts)
+}
+
+// Update changes the given attributes of the unit, provided its current version matches version.
+// A version mismatch fails with a *core.ConflictError.
+func (uc *UnitsClient) Update(ctx context.Context, id uuid.UUID, version int64, patch *models.OrganisationUnitPatchAttributes) (*models.OrganisationUnitResponse, error) {
+	unitResponse := &models.OrganisationUnitResponse{}
+
+	body := &models.OrganisationUnitPatchRequest{
+		Data: &models.OrganisationUnitPatchData{
+			Attributes: patch,
+			ID:         id.String(),
+			Type:       unitsType,
+			Version:    &version,
+		},
+	}
+
+	builder := core.NewRequestBuilder(http.MethodPatch).
+		WithPath(baseUnitsPath).
+		WithPath(id.String()).
+		WithBody(body)
+
+	if err := core.SendExpecting(ctx, uc.organisations.baseClient, builder, http.StatusOK, unitResponse); err != nil {
+		return nil, err
+	}
+
+	return unitResponse, nil
+}
+
+// Delete deletes the unit. The API refuses with a *core.ConflictError to delete a unit that still has children.
+func (uc *UnitsClient) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	builder := core.NewRequestBuilder(http.MethodDelete).
+		WithPath(baseUnitsPath).
+		WithPath(id.String()).
+		WithQueryParam("version", strconv.FormatInt(version, 10))
+
+	return core.SendExpecting(ctx, uc.organisations.baseClient, builder, http.StatusNoContent, nil)
+}
+
+func (opts *UnitListOptions) apply(builder core.RequestBuilder) core.RequestBuilder {
+	return core.ApplyList(builder, opts.PageOptions, core.ListFilters{
+		"filter[organisation_id]": opts.Filter.OrganisationID,
+		"filter[name]":            opts.Filter.Name,
+		"filter[parent_id]":       opts.Filter.ParentID,
+	})
+}
@@ -0,0 +1,238 @@
+package organisations
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUnitsFetch(t *testing.T) {
+	t.Run("Given a response with status code 200 should return the unit", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient).Units
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), id)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, http.MethodGet, mockedBaseClient.SentRequest(0).Method)
+		assert.Equal(t, baseUnitsPath+"/"+id.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code 404 should return a not found error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(404), nil)
+
+		sut := New(mockedBaseClient).Units
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
+
+func TestUnitsCreate(t *testing.T) {
+	t.Run("Given a unit under a parent unit should send it with an idempotency key", func(t *testing.T) {
+		// Arrange
+		unit := &models.OrganisationUnitRequest{Data: &models.OrganisationUnitData{
+			ID:             uuid.NewString(),
+			OrganisationID: uuid.NewString(),
+			Type:           "organisation_units",
+			Attributes:     &models.OrganisationUnitAttributes{Name: "Treasury"},
+			Relationships:  models.NewParentRelationship(uuid.NewString(), "organisation_units"),
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient).Units
+
+		// Act
+		actual, err := sut.Create(context.Background(), unit)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, baseUnitsPath, apiReq.Path)
+		assert.Equal(t, unit, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the unit with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient).Units
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.Create(ctx, &models.OrganisationUnitRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+baseUnitsPath, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a duplicate conflict after a retried attempt should return the unit created by the earlier attempt", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		organisationID := uuid.NewString()
+		unit := &models.OrganisationUnitRequest{Data: &models.OrganisationUnitData{ID: id.String(), OrganisationID: organisationID}}
+
+		conflict := coretest.NewResponse(409)
+		conflict.Attempts = 2
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPost)).Return(conflict, nil)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).
+			Run(func(args mock.Arguments) {
+				result := args.Get(0).(*core.Request).Result.(*models.OrganisationUnitResponse)
+				result.Data = &models.OrganisationUnitData{ID: id.String(), OrganisationID: organisationID}
+			})
+
+		sut := New(mockedBaseClient).Units
+
+		// Act
+		actual, err := sut.Create(context.Background(), unit)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, id.String(), actual.Data.ID)
+		assert.Equal(t, baseUnitsPath+"/"+id.String(), mockedBaseClient.SentRequest(1).Path)
+	})
+
+	t.Run("Given a response with status code other than 201 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(400), nil)
+
+		sut := New(mockedBaseClient).Units
+
+		// Act
+		actual, err := sut.Create(context.Background(), &models.OrganisationUnitRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
+	})
+}
+
+func TestUnitsList(t *testing.T) {
+	t.Run("Given paging and filters should send them as query parameters", func(t *testing.T) {
+		// Arrange
+		organisationID := uuid.NewString()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient).Units
+
+		opts := &UnitListOptions{
+			PageOptions: core.PageOptions{PageNumber: 3},
+			Filter:      UnitListFilter{OrganisationID: []string{organisationID}},
+		}
+
+		// Act
+		actual, err := sut.List(context.Background(), opts)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, baseUnitsPath, apiReq.Path)
+		assert.Equal(t, "3", apiReq.QueryParam.Get("page[number]"))
+		assert.Equal(t, []string{organisationID}, apiReq.QueryParam["filter[organisation_id]"])
+		assert.NotContains(t, apiReq.QueryParam, "filter[name]")
+	})
+}
+
+func TestUnitsChildren(t *testing.T) {
+	t.Run("Given a unit should list the units nested under it", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient).Units
+
+		// Act
+		actual, err := sut.Children(context.Background(), id, nil)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, []string{id.String()}, mockedBaseClient.SentRequest(0).QueryParam["filter[parent_id]"])
+	})
+}
+
+func TestUnitsUpdate(t *testing.T) {
+	t.Run("Given a patch should send it with the version", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		patch := &models.OrganisationUnitPatchAttributes{Addresses: []*models.Address{{City: "Paris", Country: "FR"}}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient).Units
+
+		// Act
+		actual, err := sut.Update(context.Background(), id, 1, patch)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPatch, apiReq.Method)
+		assert.Equal(t, baseUnitsPath+"/"+id.String(), apiReq.Path)
+		body := apiReq.Body.(*models.OrganisationUnitPatchRequest)
+		assert.Equal(t, unitsType, body.Data.Type)
+		assert.Equal(t, int64(1), *body.Data.Version)
+		assert.Equal(t, patch, body.Data.Attributes)
+	})
+}
+
+func TestUnitsDelete(t *testing.T) {
+	t.Run("Given a response with status code 204 should send the version and return no error", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(204), nil)
+
+		sut := New(mockedBaseClient).Units
+
+		// Act
+		err := sut.Delete(context.Background(), id, 0)
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodDelete, apiReq.Method)
+		assert.Equal(t, baseUnitsPath+"/"+id.String(), apiReq.Path)
+		assert.Equal(t, "0", apiReq.QueryParam.Get("version"))
+	})
+}
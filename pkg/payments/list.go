@@ -0,0 +1,59 @@
+package payments
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+)
+
+// ListOptions holds the paging and filtering parameters used when listing payments.
+type ListOptions struct {
+	core.PageOptions
+	Filter ListFilter
+}
+
+// ListFilter restricts the payments returned by List. Slice fields accept multiple values and
+// processing dates use the YYYY-MM-DD format.
+type ListFilter struct {
+	Currency                 []string
+	PaymentScheme            []string
+	Amount                   []string
+	EndToEndReference        []string
+	DebtorAccountNumber      []string
+	BeneficiaryAccountNumber []string
+	ProcessingDateFrom       string
+	ProcessingDateTo         string
+}
+
+// List returns a single page of payments together with the links to the surrounding pages.
+func (pc *PaymentsClient) List(ctx context.Context, opts *ListOptions) (*models.PaymentListResponse, error) {
+	listResponse := &models.PaymentListResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath)
+
+	if opts != nil {
+		builder = opts.apply(builder)
+	}
+
+	if err := core.SendExpecting(ctx, pc.baseClient, builder, http.StatusOK, listResponse); err != nil {
+		return nil, err
+	}
+
+	return listResponse, nil
+}
+
+func (opts *ListOptions) apply(builder core.RequestBuilder) core.RequestBuilder {
+	return core.ApplyList(builder, opts.PageOptions, core.ListFilters{
+		"filter[currency]":                         opts.Filter.Currency,
+		"filter[payment_scheme]":                   opts.Filter.PaymentScheme,
+		"filter[amount]":                           opts.Filter.Amount,
+		"filter[end_to_end_reference]":             opts.Filter.EndToEndReference,
+		"filter[debtor_party.account_number]":      opts.Filter.DebtorAccountNumber,
+		"filter[beneficiary_party.account_number]": opts.Filter.BeneficiaryAccountNumber,
+		"filter[processing_date_from]":             {opts.Filter.ProcessingDateFrom},
+		"filter[processing_date_to]":               {opts.Filter.ProcessingDateTo},
+	})
+}
@@ -0,0 +1,81 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestList(t *testing.T) {
+	t.Run("Given paging and filters should send them as query parameters", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		opts := &ListOptions{
+			PageOptions: core.PageOptions{PageNumber: 2, PageSize: 50},
+			Filter: ListFilter{
+				Currency:            []string{"GBP", "EUR"},
+				DebtorAccountNumber: []string{"71268996"},
+				ProcessingDateFrom:  "2021-01-01",
+				ProcessingDateTo:    "2021-01-31",
+			},
+		}
+
+		// Act
+		actual, err := sut.List(context.Background(), opts)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodGet, apiReq.Method)
+		assert.Equal(t, basePaymentsPath, apiReq.Path)
+		assert.Equal(t, "2", apiReq.QueryParam.Get("page[number]"))
+		assert.Equal(t, "50", apiReq.QueryParam.Get("page[size]"))
+		assert.Equal(t, []string{"GBP", "EUR"}, apiReq.QueryParam["filter[currency]"])
+		assert.Equal(t, []string{"71268996"}, apiReq.QueryParam["filter[debtor_party.account_number]"])
+		assert.Equal(t, "2021-01-01", apiReq.QueryParam.Get("filter[processing_date_from]"))
+		assert.Equal(t, "2021-01-31", apiReq.QueryParam.Get("filter[processing_date_to]"))
+		assert.NotContains(t, apiReq.QueryParam, "filter[amount]")
+	})
+
+	t.Run("Given no options should not send query parameters", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.List(context.Background(), nil)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Empty(t, mockedBaseClient.SentRequest(0).QueryParam)
+	})
+
+	t.Run("Given a response with status code other than 200 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(500), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.List(context.Background(), nil)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrServerError))
+	})
+}
@@ -0,0 +1,73 @@
+// Package payments provides the client of the payments API resources: payments, their submissions
+// to the schemes, the admissions of incoming payments and the returns, reversals and recalls of payments.
+package payments
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	basePaymentsPath string = "/v1/transaction/payments"
+	submissionsPath  string = "submissions"
+	admissionsPath   string = "admissions"
+	returnsPath      string = "returns"
+	reversalsPath    string = "reversals"
+	recallsPath      string = "recalls"
+	decisionsPath    string = "decisions"
+)
+
+// PaymentsClient handles payments, while the exception flows on a payment are handled by
+// the Returns, Reversals and Recalls sub-clients.
+type PaymentsClient struct {
+	baseClient core.Client
+	Returns    *ReturnsClient
+	Reversals  *ReversalsClient
+	Recalls    *RecallsClient
+}
+
+func New(baseClient core.Client) *PaymentsClient {
+	pc := &PaymentsClient{
+		baseClient: baseClient,
+	}
+
+	pc.Returns = &ReturnsClient{payments: pc}
+	pc.Reversals = &ReversalsClient{payments: pc}
+	pc.Recalls = &RecallsClient{payments: pc}
+
+	return pc
+}
+
+func (pc *PaymentsClient) Fetch(ctx context.Context, id uuid.UUID) (*models.PaymentResponse, error) {
+	paymentResponse := &models.PaymentResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(id.String())
+
+	if err := core.SendExpecting(ctx, pc.baseClient, builder, http.StatusOK, paymentResponse); err != nil {
+		return nil, err
+	}
+
+	return paymentResponse, nil
+}
+
+// Create sends the payment with an idempotency key, so that it is retried safely, and returns the payment
+// created by an earlier attempt instead of a conflict when there is one, see core.CreateRecord.
+func (pc *PaymentsClient) Create(ctx context.Context, payment *models.PaymentRequest) (*models.PaymentResponse, error) {
+	paymentResponse := &models.PaymentResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithBody(payment)
+
+	if err := core.CreateRecord(ctx, pc.baseClient, builder, paymentResponse); err != nil {
+		return nil, err
+	}
+
+	return paymentResponse, nil
+}
@@ -0,0 +1,160 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetch(t *testing.T) {
+	t.Run("Given an error calling base client should return an error", func(t *testing.T) {
+		// Arrange
+		expectedError := fmt.Errorf("Some error occurred")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given a response with status code 200 should return the payment", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), id)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, http.MethodGet, mockedBaseClient.SentRequest(0).Method)
+		assert.Equal(t, basePaymentsPath+"/"+id.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code 404 should return a not found error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(404), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Given a response with status code 201 should return the payment", func(t *testing.T) {
+		// Arrange
+		payment := &models.PaymentRequest{Data: &models.PaymentData{
+			ID:   uuid.NewString(),
+			Type: "payments",
+			Attributes: &models.PaymentAttributes{
+				Amount:        "100.21",
+				Currency:      "GBP",
+				PaymentScheme: models.SchemeFPS,
+			},
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), payment)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, basePaymentsPath, apiReq.Path)
+		assert.Equal(t, payment, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a response with status code 400 should return a bad request error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(400), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), &models.PaymentRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
+	})
+
+	t.Run("Given a duplicate conflict after a retried attempt should return the payment created by the earlier attempt", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		organisationID := uuid.NewString()
+		payment := &models.PaymentRequest{Data: &models.PaymentData{ID: id.String(), OrganisationID: organisationID}}
+
+		conflict := coretest.NewResponse(409)
+		conflict.Attempts = 2
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPost)).Return(conflict, nil)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).Run(func(args mock.Arguments) {
+			result := args.Get(0).(*core.Request).Result.(*models.PaymentResponse)
+			result.Data = &models.PaymentData{ID: id.String(), OrganisationID: organisationID}
+		})
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), payment)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, id.String(), actual.Data.ID)
+	})
+
+	t.Run("Given a duplicate conflict on the first attempt should return the conflict error without fetching", func(t *testing.T) {
+		// Arrange
+		payment := &models.PaymentRequest{Data: &models.PaymentData{ID: uuid.NewString()}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPost)).Return(coretest.NewResponse(409), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), payment)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+		mockedBaseClient.AssertNumberOfCalls(t, "Send", 1)
+	})
+}
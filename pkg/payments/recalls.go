@@ -0,0 +1,124 @@
+package payments
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+// RecallsClient handles the recalls of payments, the decisions taken on received recalls and
+// the submissions of those decisions to the scheme, under /payments/{id}/recalls.
+type RecallsClient struct {
+	payments *PaymentsClient
+}
+
+func (rc *RecallsClient) Create(ctx context.Context, paymentID uuid.UUID, recall *models.RecallRequest) (*models.RecallResponse, error) {
+	recallResponse := &models.RecallResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(recallsPath).
+		WithBody(recall)
+
+	if err := core.CreateRecord(ctx, rc.payments.baseClient, builder, recallResponse); err != nil {
+		return nil, err
+	}
+
+	return recallResponse, nil
+}
+
+func (rc *RecallsClient) Fetch(ctx context.Context, paymentID uuid.UUID, recallID uuid.UUID) (*models.RecallResponse, error) {
+	recallResponse := &models.RecallResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(recallsPath).
+		WithPath(recallID.String())
+
+	if err := core.SendExpecting(ctx, rc.payments.baseClient, builder, http.StatusOK, recallResponse); err != nil {
+		return nil, err
+	}
+
+	return recallResponse, nil
+}
+
+func (rc *RecallsClient) CreateDecision(ctx context.Context, paymentID uuid.UUID, recallID uuid.UUID, decision *models.RecallDecisionRequest) (*models.RecallDecisionResponse, error) {
+	decisionResponse := &models.RecallDecisionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(recallsPath).
+		WithPath(recallID.String()).
+		WithPath(decisionsPath).
+		WithBody(decision)
+
+	if err := core.CreateRecord(ctx, rc.payments.baseClient, builder, decisionResponse); err != nil {
+		return nil, err
+	}
+
+	return decisionResponse, nil
+}
+
+func (rc *RecallsClient) FetchDecision(ctx context.Context, paymentID uuid.UUID, recallID uuid.UUID, decisionID uuid.UUID) (*models.RecallDecisionResponse, error) {
+	decisionResponse := &models.RecallDecisionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(recallsPath).
+		WithPath(recallID.String()).
+		WithPath(decisionsPath).
+		WithPath(decisionID.String())
+
+	if err := core.SendExpecting(ctx, rc.payments.baseClient, builder, http.StatusOK, decisionResponse); err != nil {
+		return nil, err
+	}
+
+	return decisionResponse, nil
+}
+
+func (rc *RecallsClient) CreateDecisionSubmission(ctx context.Context, paymentID uuid.UUID, recallID uuid.UUID, decisionID uuid.UUID, submission *models.RecallDecisionSubmissionRequest) (*models.RecallDecisionSubmissionResponse, error) {
+	submissionResponse := &models.RecallDecisionSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(recallsPath).
+		WithPath(recallID.String()).
+		WithPath(decisionsPath).
+		WithPath(decisionID.String()).
+		WithPath(submissionsPath).
+		WithBody(submission)
+
+	if err := core.CreateRecord(ctx, rc.payments.baseClient, builder, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
+
+func (rc *RecallsClient) FetchDecisionSubmission(ctx context.Context, paymentID uuid.UUID, recallID uuid.UUID, decisionID uuid.UUID, submissionID uuid.UUID) (*models.RecallDecisionSubmissionResponse, error) {
+	submissionResponse := &models.RecallDecisionSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(recallsPath).
+		WithPath(recallID.String()).
+		WithPath(decisionsPath).
+		WithPath(decisionID.String()).
+		WithPath(submissionsPath).
+		WithPath(submissionID.String())
+
+	if err := core.SendExpecting(ctx, rc.payments.baseClient, builder, http.StatusOK, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
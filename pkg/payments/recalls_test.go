@@ -0,0 +1,162 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecalls(t *testing.T) {
+	paymentID, recallID, decisionID, submissionID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	recallPath := basePaymentsPath + "/" + paymentID.String() + "/recalls/" + recallID.String()
+
+	t.Run("Given a recall should send it to the payment recalls with an idempotency key", func(t *testing.T) {
+		// Arrange
+		recall := &models.RecallRequest{Data: &models.RecallData{
+			ID:         recallID.String(),
+			Type:       "recalls",
+			Attributes: &models.RecallAttributes{Reason: models.RecallDuplicatePayment},
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Recalls.Create(context.Background(), paymentID, recall)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, basePaymentsPath+"/"+paymentID.String()+"/recalls", apiReq.Path)
+		assert.Equal(t, recall, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the recall with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.Recalls.Create(ctx, paymentID, &models.RecallRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+mockedBaseClient.SentRequest(0).Path, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a recall id should fetch it from the payment recalls", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Recalls.Fetch(context.Background(), paymentID, recallID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, recallPath, mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a recall decision should send it to the recall decisions", func(t *testing.T) {
+		// Arrange
+		decision := &models.RecallDecisionRequest{Data: &models.RecallDecisionData{
+			ID:         decisionID.String(),
+			Type:       "recall_decisions",
+			Attributes: &models.RecallDecisionAttributes{Answer: models.RecallRejected, RejectReason: models.RecallRejectedAlreadyReturned},
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Recalls.CreateDecision(context.Background(), paymentID, recallID, decision)
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, recallPath+"/decisions", apiReq.Path)
+		assert.Equal(t, decision, apiReq.Body)
+	})
+
+	t.Run("Given a recall decision id should fetch it from the recall decisions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Recalls.FetchDecision(context.Background(), paymentID, recallID, decisionID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, recallPath+"/decisions/"+decisionID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a recall decision submission should send it to the decision submissions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Recalls.CreateDecisionSubmission(context.Background(), paymentID, recallID, decisionID, &models.RecallDecisionSubmissionRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, mockedBaseClient.SentRequest(0).Method)
+		assert.Equal(t, recallPath+"/decisions/"+decisionID.String()+"/submissions", mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a recall decision submission id should fetch it from the decision submissions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Recalls.FetchDecisionSubmission(context.Background(), paymentID, recallID, decisionID, submissionID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, recallPath+"/decisions/"+decisionID.String()+"/submissions/"+submissionID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code other than 200 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(404), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Recalls.FetchDecision(context.Background(), paymentID, recallID, decisionID)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
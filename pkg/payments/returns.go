@@ -0,0 +1,84 @@
+package payments
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+// ReturnsClient handles the returns of received payments and their submissions to the scheme,
+// under /payments/{id}/returns.
+type ReturnsClient struct {
+	payments *PaymentsClient
+}
+
+func (rc *ReturnsClient) Create(ctx context.Context, paymentID uuid.UUID, paymentReturn *models.ReturnRequest) (*models.ReturnResponse, error) {
+	returnResponse := &models.ReturnResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(returnsPath).
+		WithBody(paymentReturn)
+
+	if err := core.CreateRecord(ctx, rc.payments.baseClient, builder, returnResponse); err != nil {
+		return nil, err
+	}
+
+	return returnResponse, nil
+}
+
+func (rc *ReturnsClient) Fetch(ctx context.Context, paymentID uuid.UUID, returnID uuid.UUID) (*models.ReturnResponse, error) {
+	returnResponse := &models.ReturnResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(returnsPath).
+		WithPath(returnID.String())
+
+	if err := core.SendExpecting(ctx, rc.payments.baseClient, builder, http.StatusOK, returnResponse); err != nil {
+		return nil, err
+	}
+
+	return returnResponse, nil
+}
+
+func (rc *ReturnsClient) CreateSubmission(ctx context.Context, paymentID uuid.UUID, returnID uuid.UUID, submission *models.ReturnSubmissionRequest) (*models.ReturnSubmissionResponse, error) {
+	submissionResponse := &models.ReturnSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(returnsPath).
+		WithPath(returnID.String()).
+		WithPath(submissionsPath).
+		WithBody(submission)
+
+	if err := core.CreateRecord(ctx, rc.payments.baseClient, builder, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
+
+func (rc *ReturnsClient) FetchSubmission(ctx context.Context, paymentID uuid.UUID, returnID uuid.UUID, submissionID uuid.UUID) (*models.ReturnSubmissionResponse, error) {
+	submissionResponse := &models.ReturnSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(returnsPath).
+		WithPath(returnID.String()).
+		WithPath(submissionsPath).
+		WithPath(submissionID.String())
+
+	if err := core.SendExpecting(ctx, rc.payments.baseClient, builder, http.StatusOK, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
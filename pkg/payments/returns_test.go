@@ -0,0 +1,123 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReturns(t *testing.T) {
+	paymentID, returnID, submissionID := uuid.New(), uuid.New(), uuid.New()
+	returnPath := basePaymentsPath + "/" + paymentID.String() + "/returns"
+
+	t.Run("Given a return should send it to the payment returns with an idempotency key", func(t *testing.T) {
+		// Arrange
+		paymentReturn := &models.ReturnRequest{Data: &models.ReturnData{
+			ID:         returnID.String(),
+			Type:       "returns",
+			Attributes: &models.ReturnAttributes{ReturnCode: models.ReturnClosedAccount},
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Returns.Create(context.Background(), paymentID, paymentReturn)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, returnPath, apiReq.Path)
+		assert.Equal(t, paymentReturn, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the return with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.Returns.Create(ctx, paymentID, &models.ReturnRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+mockedBaseClient.SentRequest(0).Path, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a return id should fetch it from the payment returns", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Returns.Fetch(context.Background(), paymentID, returnID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, returnPath+"/"+returnID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a return submission should send it to the return submissions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Returns.CreateSubmission(context.Background(), paymentID, returnID, &models.ReturnSubmissionRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, mockedBaseClient.SentRequest(0).Method)
+		assert.Equal(t, returnPath+"/"+returnID.String()+"/submissions", mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a return submission id should fetch it from the return submissions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Returns.FetchSubmission(context.Background(), paymentID, returnID, submissionID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, returnPath+"/"+returnID.String()+"/submissions/"+submissionID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code other than 201 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(400), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Returns.Create(context.Background(), paymentID, &models.ReturnRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
+	})
+}
@@ -0,0 +1,84 @@
+package payments
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+// ReversalsClient handles the reversals of sent payments and their submissions to the scheme,
+// under /payments/{id}/reversals.
+type ReversalsClient struct {
+	payments *PaymentsClient
+}
+
+func (rc *ReversalsClient) Create(ctx context.Context, paymentID uuid.UUID, reversal *models.ReversalRequest) (*models.ReversalResponse, error) {
+	reversalResponse := &models.ReversalResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(reversalsPath).
+		WithBody(reversal)
+
+	if err := core.CreateRecord(ctx, rc.payments.baseClient, builder, reversalResponse); err != nil {
+		return nil, err
+	}
+
+	return reversalResponse, nil
+}
+
+func (rc *ReversalsClient) Fetch(ctx context.Context, paymentID uuid.UUID, reversalID uuid.UUID) (*models.ReversalResponse, error) {
+	reversalResponse := &models.ReversalResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(reversalsPath).
+		WithPath(reversalID.String())
+
+	if err := core.SendExpecting(ctx, rc.payments.baseClient, builder, http.StatusOK, reversalResponse); err != nil {
+		return nil, err
+	}
+
+	return reversalResponse, nil
+}
+
+func (rc *ReversalsClient) CreateSubmission(ctx context.Context, paymentID uuid.UUID, reversalID uuid.UUID, submission *models.ReversalSubmissionRequest) (*models.ReversalSubmissionResponse, error) {
+	submissionResponse := &models.ReversalSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(reversalsPath).
+		WithPath(reversalID.String()).
+		WithPath(submissionsPath).
+		WithBody(submission)
+
+	if err := core.CreateRecord(ctx, rc.payments.baseClient, builder, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
+
+func (rc *ReversalsClient) FetchSubmission(ctx context.Context, paymentID uuid.UUID, reversalID uuid.UUID, submissionID uuid.UUID) (*models.ReversalSubmissionResponse, error) {
+	submissionResponse := &models.ReversalSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(reversalsPath).
+		WithPath(reversalID.String()).
+		WithPath(submissionsPath).
+		WithPath(submissionID.String())
+
+	if err := core.SendExpecting(ctx, rc.payments.baseClient, builder, http.StatusOK, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
@@ -0,0 +1,123 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReversals(t *testing.T) {
+	paymentID, reversalID, submissionID := uuid.New(), uuid.New(), uuid.New()
+	reversalPath := basePaymentsPath + "/" + paymentID.String() + "/reversals"
+
+	t.Run("Given a reversal should send it to the payment reversals with an idempotency key", func(t *testing.T) {
+		// Arrange
+		reversal := &models.ReversalRequest{Data: &models.ReversalData{
+			ID:         reversalID.String(),
+			Type:       "reversals",
+			Attributes: &models.ReversalAttributes{ReversalCode: models.ReversalDuplication},
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Reversals.Create(context.Background(), paymentID, reversal)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, reversalPath, apiReq.Path)
+		assert.Equal(t, reversal, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the reversal with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.Reversals.Create(ctx, paymentID, &models.ReversalRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+mockedBaseClient.SentRequest(0).Path, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a reversal id should fetch it from the payment reversals", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Reversals.Fetch(context.Background(), paymentID, reversalID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, reversalPath+"/"+reversalID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a reversal submission should send it to the reversal submissions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Reversals.CreateSubmission(context.Background(), paymentID, reversalID, &models.ReversalSubmissionRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, mockedBaseClient.SentRequest(0).Method)
+		assert.Equal(t, reversalPath+"/"+reversalID.String()+"/submissions", mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a reversal submission id should fetch it from the reversal submissions", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		_, err := sut.Reversals.FetchSubmission(context.Background(), paymentID, reversalID, submissionID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, reversalPath+"/"+reversalID.String()+"/submissions/"+submissionID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code other than 201 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(400), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Reversals.Create(context.Background(), paymentID, &models.ReversalRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
+	})
+}
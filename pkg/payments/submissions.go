@@ -0,0 +1,76 @@
+package payments
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+// CreateSubmission submits the payment to its scheme. The submission status is then followed with FetchSubmission.
+func (pc *PaymentsClient) CreateSubmission(ctx context.Context, paymentID uuid.UUID, submission *models.PaymentSubmissionRequest) (*models.PaymentSubmissionResponse, error) {
+	submissionResponse := &models.PaymentSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(submissionsPath).
+		WithBody(submission)
+
+	if err := core.CreateRecord(ctx, pc.baseClient, builder, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
+
+func (pc *PaymentsClient) FetchSubmission(ctx context.Context, paymentID uuid.UUID, submissionID uuid.UUID) (*models.PaymentSubmissionResponse, error) {
+	submissionResponse := &models.PaymentSubmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(submissionsPath).
+		WithPath(submissionID.String())
+
+	if err := core.SendExpecting(ctx, pc.baseClient, builder, http.StatusOK, submissionResponse); err != nil {
+		return nil, err
+	}
+
+	return submissionResponse, nil
+}
+
+// CreateAdmission records the admission of an incoming payment.
+func (pc *PaymentsClient) CreateAdmission(ctx context.Context, paymentID uuid.UUID, admission *models.PaymentAdmissionRequest) (*models.PaymentAdmissionResponse, error) {
+	admissionResponse := &models.PaymentAdmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(admissionsPath).
+		WithBody(admission)
+
+	if err := core.CreateRecord(ctx, pc.baseClient, builder, admissionResponse); err != nil {
+		return nil, err
+	}
+
+	return admissionResponse, nil
+}
+
+func (pc *PaymentsClient) FetchAdmission(ctx context.Context, paymentID uuid.UUID, admissionID uuid.UUID) (*models.PaymentAdmissionResponse, error) {
+	admissionResponse := &models.PaymentAdmissionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(basePaymentsPath).
+		WithPath(paymentID.String()).
+		WithPath(admissionsPath).
+		WithPath(admissionID.String())
+
+	if err := core.SendExpecting(ctx, pc.baseClient, builder, http.StatusOK, admissionResponse); err != nil {
+		return nil, err
+	}
+
+	return admissionResponse, nil
+}
@@ -0,0 +1,146 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSubmissions(t *testing.T) {
+	t.Run("Given a submission should send it to the payment submissions with an idempotency key", func(t *testing.T) {
+		// Arrange
+		paymentID := uuid.New()
+		submission := &models.PaymentSubmissionRequest{Data: &models.PaymentSubmissionData{ID: uuid.NewString(), Type: "payment_submissions"}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.CreateSubmission(context.Background(), paymentID, submission)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, basePaymentsPath+"/"+paymentID.String()+"/submissions", apiReq.Path)
+		assert.Equal(t, submission, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the submission with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.CreateSubmission(ctx, uuid.New(), &models.PaymentSubmissionRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+mockedBaseClient.SentRequest(0).Path, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a submission id should fetch it from the payment submissions", func(t *testing.T) {
+		// Arrange
+		paymentID, submissionID := uuid.New(), uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.FetchSubmission(context.Background(), paymentID, submissionID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, basePaymentsPath+"/"+paymentID.String()+"/submissions/"+submissionID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code other than 201 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(404), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.CreateSubmission(context.Background(), uuid.New(), &models.PaymentSubmissionRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
+
+func TestAdmissions(t *testing.T) {
+	t.Run("Given an admission should send it to the payment admissions", func(t *testing.T) {
+		// Arrange
+		paymentID := uuid.New()
+		admission := &models.PaymentAdmissionRequest{Data: &models.PaymentAdmissionData{ID: uuid.NewString(), Type: "payment_admissions"}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.CreateAdmission(context.Background(), paymentID, admission)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, basePaymentsPath+"/"+paymentID.String()+"/admissions", apiReq.Path)
+		assert.Equal(t, admission, apiReq.Body)
+	})
+
+	t.Run("Given an admission id should fetch it from the payment admissions", func(t *testing.T) {
+		// Arrange
+		paymentID, admissionID := uuid.New(), uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.FetchAdmission(context.Background(), paymentID, admissionID)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, basePaymentsPath+"/"+paymentID.String()+"/admissions/"+admissionID.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code other than 200 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(500), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.FetchAdmission(context.Background(), uuid.New(), uuid.New())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrServerError))
+	})
+}
@@ -0,0 +1,96 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Signer signs http requests with a private key identified by a key id.
+// It implements core.RequestSigner so it can be set in client.WithRequestSigner.
+type Signer struct {
+	keyID     string
+	key       crypto.PrivateKey
+	algorithm string
+	headers   []string
+	now       func() time.Time
+}
+
+// NewSigner returns a Signer for an *rsa.PrivateKey or an ed25519.PrivateKey.
+// The signature covers the given headers, or DefaultHeaders when none are given.
+func NewSigner(keyID string, key crypto.PrivateKey, headers ...string) (*Signer, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("key id must not be empty")
+	}
+
+	var algorithm string
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		algorithm = AlgorithmRSASHA256
+	case ed25519.PrivateKey:
+		algorithm = AlgorithmEd25519
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	if len(headers) == 0 {
+		headers = DefaultHeaders
+	}
+
+	return &Signer{
+		keyID:     keyID,
+		key:       key,
+		algorithm: algorithm,
+		headers:   headers,
+		now:       time.Now,
+	}, nil
+}
+
+// SignRequest sets the Date and Digest headers of the request when they are missing
+// and adds the Signature header covering the configured headers.
+func (s *Signer) SignRequest(req *http.Request) error {
+	body, err := readBody(req)
+	if err != nil {
+		return fmt.Errorf("error reading request body: %w", err)
+	}
+
+	req.Header.Set(DigestHeader, computeDigest(body))
+
+	if req.Header.Get("Date") == "" {
+		req.Header.Set("Date", s.now().UTC().Format(http.TimeFormat))
+	}
+
+	toSign, err := signingString(req, s.headers)
+	if err != nil {
+		return err
+	}
+
+	signature, err := s.sign([]byte(toSign))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set(SignatureHeader, fmt.Sprintf(`keyId="%s",algorithm="%s",headers="%s",signature="%s"`,
+		s.keyID, s.algorithm, strings.ToLower(strings.Join(s.headers, " ")), base64.StdEncoding.EncodeToString(signature)))
+
+	return nil
+}
+
+func (s *Signer) sign(message []byte) ([]byte, error) {
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		hashed := sha256.Sum256(message)
+		return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	case ed25519.PrivateKey:
+		return ed25519.Sign(key, message), nil
+	}
+
+	return nil, fmt.Errorf("unsupported private key type %T", s.key)
+}
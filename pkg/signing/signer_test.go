@@ -0,0 +1,91 @@
+package signing
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSigner(t *testing.T) {
+	t.Run("Given an unsupported key type should return an error", func(t *testing.T) {
+		// Arrange
+		key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+		// Act
+		actual, err := NewSigner("some_key_id", key)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given an empty key id should return an error", func(t *testing.T) {
+		// Arrange
+		_, key, _ := ed25519.GenerateKey(rand.Reader)
+
+		// Act
+		actual, err := NewSigner("", key)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestSignRequest(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	_, ed25519Key, _ := ed25519.GenerateKey(rand.Reader)
+
+	testCases := []struct {
+		key       interface{}
+		algorithm string
+	}{
+		{rsaKey, AlgorithmRSASHA256},
+		{ed25519Key, AlgorithmEd25519},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given a request should add the digest, date and signature headers", func(t *testing.T) {
+			// Arrange
+			req, _ := http.NewRequest(http.MethodPost, "http://example.com/v1/organisation/accounts", bytes.NewReader([]byte(`{"data":{}}`)))
+
+			sut, _ := NewSigner("some_key_id", tc.key)
+			sut.now = func() time.Time { return time.Date(2022, 6, 7, 20, 51, 35, 0, time.UTC) }
+
+			// Act
+			err := sut.SignRequest(req)
+
+			// Assert
+			assert.Nil(t, err)
+			assert.Equal(t, computeDigest([]byte(`{"data":{}}`)), req.Header.Get(DigestHeader))
+			assert.Equal(t, "Tue, 07 Jun 2022 20:51:35 GMT", req.Header.Get("Date"))
+
+			signature := req.Header.Get(SignatureHeader)
+			assert.True(t, strings.HasPrefix(signature, `keyId="some_key_id",algorithm="`+tc.algorithm+`",headers="(request-target) host date digest content-length",signature="`))
+		})
+	}
+
+	t.Run("Given a request with a date header should keep it", func(t *testing.T) {
+		// Arrange
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		req.Header.Set("Date", "Mon, 06 Jun 2022 10:00:00 GMT")
+
+		sut, _ := NewSigner("some_key_id", ed25519Key)
+
+		// Act
+		err := sut.SignRequest(req)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "Mon, 06 Jun 2022 10:00:00 GMT", req.Header.Get("Date"))
+	})
+}
@@ -0,0 +1,102 @@
+// Package signing implements HTTP message signatures following the draft-cavage-http-signatures
+// specification, with RSA (rsa-sha256) and Ed25519 keys.
+package signing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	AlgorithmRSASHA256 string = "rsa-sha256"
+	AlgorithmEd25519   string = "ed25519"
+
+	SignatureHeader string = "Signature"
+	DigestHeader    string = "Digest"
+
+	RequestTargetHeader string = "(request-target)"
+
+	digestAlgorithm string = "SHA-256"
+)
+
+// DefaultHeaders are the headers covered by the signature when none are configured.
+var DefaultHeaders = []string{RequestTargetHeader, "host", "date", "digest", "content-length"}
+
+var (
+	ErrMissingSignature = errors.New("missing signature")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrDigestMismatch   = errors.New("digest does not match the body")
+)
+
+// Computes the Digest header value of the body.
+func computeDigest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return digestAlgorithm + "=" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// Reads the request body and makes it readable again.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return ioutil.ReadAll(body)
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return []byte{}, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// Builds the string to sign out of the given headers, in order.
+func signingString(req *http.Request, headers []string) (string, error) {
+	lines := make([]string, 0, len(headers))
+
+	for _, header := range headers {
+		header = strings.ToLower(header)
+
+		var value string
+		switch header {
+		case RequestTargetHeader:
+			value = strings.ToLower(req.Method) + " " + req.URL.RequestURI()
+		case "host":
+			value = req.Host
+			if value == "" {
+				value = req.URL.Host
+			}
+		case "content-length":
+			value = req.Header.Get("Content-Length")
+			if value == "" {
+				value = strconv.FormatInt(req.ContentLength, 10)
+			}
+		default:
+			values := req.Header.Values(header)
+			if len(values) == 0 {
+				return "", fmt.Errorf("header '%s' is missing from the request", header)
+			}
+			value = strings.Join(values, ", ")
+		}
+
+		lines = append(lines, header+": "+value)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
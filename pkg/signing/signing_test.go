@@ -0,0 +1,70 @@
+package signing
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeDigest(t *testing.T) {
+	t.Run("Given a body should return its SHA-256 digest", func(t *testing.T) {
+		// Act
+		actual := computeDigest([]byte(`{"hello": "world"}`))
+
+		// Assert
+		assert.Equal(t, "SHA-256=X48E9qOokqqrvdts8nOJRJN3OWDUoyWxBf7kbu9DBPE=", actual)
+	})
+}
+
+func TestReadBody(t *testing.T) {
+	t.Run("Given a request body should return it and keep it readable", func(t *testing.T) {
+		// Arrange
+		req, _ := http.NewRequest(http.MethodPost, "http://example.com", ioutil.NopCloser(bytes.NewBufferString("sample body")))
+
+		// Act
+		actual, err := readBody(req)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "sample body", string(actual))
+
+		remaining, _ := ioutil.ReadAll(req.Body)
+		assert.Equal(t, "sample body", string(remaining))
+	})
+}
+
+func TestSigningString(t *testing.T) {
+	t.Run("Given the default headers should build the signing string in order", func(t *testing.T) {
+		// Arrange
+		req, _ := http.NewRequest(http.MethodPost, "http://example.com/v1/organisation/accounts?page=1", bytes.NewReader([]byte("12345")))
+		req.Header.Set("Date", "Tue, 07 Jun 2022 20:51:35 GMT")
+		req.Header.Set("Digest", "SHA-256=abc")
+
+		expected := "(request-target): post /v1/organisation/accounts?page=1\n" +
+			"host: example.com\n" +
+			"date: Tue, 07 Jun 2022 20:51:35 GMT\n" +
+			"digest: SHA-256=abc\n" +
+			"content-length: 5"
+
+		// Act
+		actual, err := signingString(req, DefaultHeaders)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Given a missing header should return an error", func(t *testing.T) {
+		// Arrange
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+		// Act
+		_, err := signingString(req, []string{"x-missing"})
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,173 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxClockSkew time.Duration = 5 * time.Minute
+)
+
+// KeyResolver returns the public key registered for a key id.
+type KeyResolver func(keyID string) (crypto.PublicKey, error)
+
+// Verifier checks the signature and digest of incoming http requests.
+type Verifier struct {
+	resolve         KeyResolver
+	requiredHeaders []string
+	// MaxClockSkew is the maximum difference allowed between the Date header and the current time.
+	// A negative value disables the check.
+	MaxClockSkew time.Duration
+	now          func() time.Time
+}
+
+// NewVerifier returns a Verifier resolving keys with resolve and requiring the signature to cover
+// the given headers, or DefaultHeaders when none are given.
+func NewVerifier(resolve KeyResolver, requiredHeaders ...string) *Verifier {
+	if len(requiredHeaders) == 0 {
+		requiredHeaders = DefaultHeaders
+	}
+
+	return &Verifier{
+		resolve:         resolve,
+		requiredHeaders: requiredHeaders,
+		MaxClockSkew:    defaultMaxClockSkew,
+		now:             time.Now,
+	}
+}
+
+// Verify returns nil when the request carries a valid signature covering the required headers
+// and, when the digest is covered, a Digest header matching its body.
+func (v *Verifier) Verify(req *http.Request) error {
+	params, err := parseSignatureHeader(req)
+	if err != nil {
+		return err
+	}
+
+	headers := strings.Fields(params["headers"])
+	if len(headers) == 0 {
+		headers = []string{"date"}
+	}
+
+	for _, required := range v.requiredHeaders {
+		if !contains(headers, strings.ToLower(required)) {
+			return fmt.Errorf("%w: header '%s' is not covered by the signature", ErrInvalidSignature, required)
+		}
+	}
+
+	if contains(headers, "digest") {
+		body, err := readBody(req)
+		if err != nil {
+			return fmt.Errorf("error reading request body: %w", err)
+		}
+		if req.Header.Get(DigestHeader) != computeDigest(body) {
+			return ErrDigestMismatch
+		}
+	}
+
+	if contains(headers, "date") && v.MaxClockSkew >= 0 {
+		date, err := http.ParseTime(req.Header.Get("Date"))
+		if err != nil {
+			return fmt.Errorf("%w: invalid date header: %v", ErrInvalidSignature, err)
+		}
+		if skew := v.now().Sub(date); skew > v.MaxClockSkew || skew < -v.MaxClockSkew {
+			return fmt.Errorf("%w: date header is outside the allowed clock skew", ErrInvalidSignature)
+		}
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(params["signature"])
+	if err != nil {
+		return fmt.Errorf("%w: signature is not valid base64", ErrInvalidSignature)
+	}
+
+	key, err := v.resolve(params["keyId"])
+	if err != nil {
+		return fmt.Errorf("%w: unknown key id '%s': %v", ErrInvalidSignature, params["keyId"], err)
+	}
+
+	toVerify, err := signingString(req, headers)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	return verify(key, params["algorithm"], []byte(toVerify), signature)
+}
+
+// Middleware rejects with 401 Unauthorized every request that fails verification.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := v.Verify(r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func verify(key crypto.PublicKey, algorithm string, message []byte, signature []byte) error {
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if algorithm != AlgorithmRSASHA256 && algorithm != "hs2019" {
+			return fmt.Errorf("%w: algorithm '%s' does not match an rsa key", ErrInvalidSignature, algorithm)
+		}
+		hashed := sha256.Sum256(message)
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], signature); err != nil {
+			return ErrInvalidSignature
+		}
+		return nil
+	case ed25519.PublicKey:
+		if algorithm != AlgorithmEd25519 && algorithm != "hs2019" {
+			return fmt.Errorf("%w: algorithm '%s' does not match an ed25519 key", ErrInvalidSignature, algorithm)
+		}
+		if !ed25519.Verify(key, message, signature) {
+			return ErrInvalidSignature
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: unsupported public key type %T", ErrInvalidSignature, key)
+}
+
+// Parses the Signature header, or an Authorization header using the Signature scheme, into its parameters.
+func parseSignatureHeader(req *http.Request) (map[string]string, error) {
+	header := req.Header.Get(SignatureHeader)
+	if header == "" {
+		authorization := req.Header.Get("Authorization")
+		if !strings.HasPrefix(authorization, "Signature ") {
+			return nil, ErrMissingSignature
+		}
+		header = strings.TrimPrefix(authorization, "Signature ")
+	}
+
+	params := map[string]string{}
+	for _, param := range strings.Split(header, ",") {
+		parts := strings.SplitN(strings.TrimSpace(param), "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%w: malformed parameter '%s'", ErrInvalidSignature, param)
+		}
+		params[parts[0]] = strings.Trim(parts[1], `"`)
+	}
+
+	if params["keyId"] == "" || params["signature"] == "" {
+		return nil, fmt.Errorf("%w: keyId and signature are required", ErrInvalidSignature)
+	}
+
+	return params, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyAgainstServer(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ed25519Public, ed25519Key, _ := ed25519.GenerateKey(rand.Reader)
+
+	keys := map[string]crypto.PublicKey{
+		"rsa_key":     &rsaKey.PublicKey,
+		"ed25519_key": ed25519Public,
+	}
+	resolve := func(keyID string) (crypto.PublicKey, error) {
+		if key, ok := keys[keyID]; ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("key not found")
+	}
+
+	server := httptest.NewServer(NewVerifier(resolve).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})))
+	defer server.Close()
+
+	baseUrl, _ := url.Parse(server.URL)
+
+	testCases := []struct {
+		keyID string
+		key   crypto.PrivateKey
+	}{
+		{"rsa_key", rsaKey},
+		{"ed25519_key", ed25519Key},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Given a request signed by the base client should be accepted by the verifier", func(t *testing.T) {
+			// Arrange
+			signer, _ := NewSigner(tc.keyID, tc.key)
+
+			sut := &core.BaseClient{
+				BaseUrl:    *baseUrl,
+				HttpClient: server.Client(),
+				Timeout:    1000,
+				Signer:     signer,
+			}
+
+			apiReq := core.NewRequestBuilder(http.MethodPost).
+				WithPath("/v1/organisation/accounts").
+				WithBody(map[string]string{"id": "some_id"}).
+				Build()
+
+			// Act
+			actual, err := sut.Send(apiReq)
+
+			// Assert
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusCreated, actual.StatusCode())
+		})
+	}
+
+	t.Run("Given an unsigned request should be rejected by the verifier", func(t *testing.T) {
+		// Arrange
+		sut := &core.BaseClient{
+			BaseUrl:    *baseUrl,
+			HttpClient: server.Client(),
+			Timeout:    1000,
+		}
+
+		// Act
+		actual, err := sut.Send(core.NewRequestBuilder(http.MethodGet).Build())
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusUnauthorized, actual.StatusCode())
+	})
+}
+
+func TestVerify(t *testing.T) {
+	public, private, _ := ed25519.GenerateKey(rand.Reader)
+	resolve := func(keyID string) (crypto.PublicKey, error) { return public, nil }
+
+	signedRequest := func(body string) *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "http://example.com/some_path", strings.NewReader(body))
+		signer, _ := NewSigner("some_key_id", private)
+		signer.SignRequest(req)
+		return req
+	}
+
+	t.Run("Given a request without signature should return ErrMissingSignature", func(t *testing.T) {
+		// Arrange
+		req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+		// Act
+		err := NewVerifier(resolve).Verify(req)
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrMissingSignature))
+	})
+
+	t.Run("Given a tampered body should return ErrDigestMismatch", func(t *testing.T) {
+		// Arrange
+		req := signedRequest("original")
+		req.Body = http.NoBody
+		req.GetBody = func() (io.ReadCloser, error) { return ioutil.NopCloser(strings.NewReader("tampered")), nil }
+
+		// Act
+		err := NewVerifier(resolve).Verify(req)
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrDigestMismatch))
+	})
+
+	t.Run("Given a tampered signed header should return ErrInvalidSignature", func(t *testing.T) {
+		// Arrange
+		req := signedRequest("original")
+		req.URL.Path = "/other_path"
+
+		// Act
+		err := NewVerifier(resolve).Verify(req)
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+
+	t.Run("Given a signature not covering a required header should return ErrInvalidSignature", func(t *testing.T) {
+		// Arrange
+		req := signedRequest("original")
+
+		// Act
+		err := NewVerifier(resolve, "date", "x-required").Verify(req)
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+
+	t.Run("Given a date outside the clock skew should return ErrInvalidSignature", func(t *testing.T) {
+		// Arrange
+		req := signedRequest("original")
+
+		sut := NewVerifier(resolve)
+		sut.now = func() time.Time { return time.Now().Add(time.Hour) }
+
+		// Act
+		err := sut.Verify(req)
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+}
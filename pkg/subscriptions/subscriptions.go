@@ -0,0 +1,129 @@
+// Package subscriptions provides the client of the notification subscriptions API, which registers
+// the callbacks notified about events such as an account being created. Callbacks can be served with
+// the events package.
+package subscriptions
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	baseSubscriptionsPath string = "/v1/notification/subscriptions"
+	subscriptionsType     string = "subscriptions"
+)
+
+type SubscriptionsClient struct {
+	baseClient core.Client
+}
+
+func New(baseClient core.Client) *SubscriptionsClient {
+	return &SubscriptionsClient{
+		baseClient: baseClient,
+	}
+}
+
+func (sc *SubscriptionsClient) Fetch(ctx context.Context, id uuid.UUID) (*models.SubscriptionResponse, error) {
+	subscriptionResponse := &models.SubscriptionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseSubscriptionsPath).
+		WithPath(id.String())
+
+	if err := core.SendExpecting(ctx, sc.baseClient, builder, http.StatusOK, subscriptionResponse); err != nil {
+		return nil, err
+	}
+
+	return subscriptionResponse, nil
+}
+
+func (sc *SubscriptionsClient) Create(ctx context.Context, subscription *models.SubscriptionRequest) (*models.SubscriptionResponse, error) {
+	subscriptionResponse := &models.SubscriptionResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodPost).
+		WithPath(baseSubscriptionsPath).
+		WithBody(subscription)
+
+	if err := core.CreateRecord(ctx, sc.baseClient, builder, subscriptionResponse); err != nil {
+		return nil, err
+	}
+
+	return subscriptionResponse, nil
+}
+
+// ListOptions holds the paging and filtering parameters used when listing subscriptions.
+type ListOptions struct {
+	core.PageOptions
+	Filter ListFilter
+}
+
+// ListFilter restricts the subscriptions returned by List. Every field accepts multiple values.
+type ListFilter struct {
+	RecordType []string
+	EventType  []string
+}
+
+// List returns a single page of subscriptions together with the links to the surrounding pages.
+func (sc *SubscriptionsClient) List(ctx context.Context, opts *ListOptions) (*models.SubscriptionListResponse, error) {
+	listResponse := &models.SubscriptionListResponse{}
+
+	builder := core.NewRequestBuilder(http.MethodGet).
+		WithPath(baseSubscriptionsPath)
+
+	if opts != nil {
+		builder = opts.apply(builder)
+	}
+
+	if err := core.SendExpecting(ctx, sc.baseClient, builder, http.StatusOK, listResponse); err != nil {
+		return nil, err
+	}
+
+	return listResponse, nil
+}
+
+// Update changes the given attributes of the subscription, provided its current version matches version.
+// A version mismatch fails with a *core.ConflictError.
+func (sc *SubscriptionsClient) Update(ctx context.Context, id uuid.UUID, version int64, patch *models.SubscriptionPatchAttributes) (*models.SubscriptionResponse, error) {
+	subscriptionResponse := &models.SubscriptionResponse{}
+
+	body := &models.SubscriptionPatchRequest{
+		Data: &models.SubscriptionPatchData{
+			Attributes: patch,
+			ID:         id.String(),
+			Type:       subscriptionsType,
+			Version:    &version,
+		},
+	}
+
+	builder := core.NewRequestBuilder(http.MethodPatch).
+		WithPath(baseSubscriptionsPath).
+		WithPath(id.String()).
+		WithBody(body)
+
+	if err := core.SendExpecting(ctx, sc.baseClient, builder, http.StatusOK, subscriptionResponse); err != nil {
+		return nil, err
+	}
+
+	return subscriptionResponse, nil
+}
+
+func (sc *SubscriptionsClient) Delete(ctx context.Context, id uuid.UUID, version int64) error {
+	builder := core.NewRequestBuilder(http.MethodDelete).
+		WithPath(baseSubscriptionsPath).
+		WithPath(id.String()).
+		WithQueryParam("version", strconv.FormatInt(version, 10))
+
+	return core.SendExpecting(ctx, sc.baseClient, builder, http.StatusNoContent, nil)
+}
+
+func (opts *ListOptions) apply(builder core.RequestBuilder) core.RequestBuilder {
+	return core.ApplyList(builder, opts.PageOptions, core.ListFilters{
+		"filter[record_type]": opts.Filter.RecordType,
+		"filter[event_type]":  opts.Filter.EventType,
+	})
+}
@@ -0,0 +1,283 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/internal/coretest"
+	"github.com/danimagb/api-client/pkg/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetch(t *testing.T) {
+	t.Run("Given an error calling base client should return an error", func(t *testing.T) {
+		// Arrange
+		expectedError := fmt.Errorf("Some error occurred")
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(nil, expectedError)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("Given a response with status code 200 should return the subscription", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), id)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, http.MethodGet, mockedBaseClient.SentRequest(0).Method)
+		assert.Equal(t, baseSubscriptionsPath+"/"+id.String(), mockedBaseClient.SentRequest(0).Path)
+	})
+
+	t.Run("Given a response with status code 404 should return a not found error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(404), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Fetch(context.Background(), uuid.New())
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Given a subscription should send it with an idempotency key", func(t *testing.T) {
+		// Arrange
+		subscription := &models.SubscriptionRequest{Data: &models.SubscriptionData{
+			ID:   uuid.NewString(),
+			Type: "subscriptions",
+			Attributes: &models.SubscriptionAttributes{
+				CallbackTransport: models.CallbackTransportHTTP,
+				CallbackURI:       "https://example.com/callbacks",
+				EventType:         "created",
+				RecordType:        "accounts",
+			},
+		}}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), subscription)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPost, apiReq.Method)
+		assert.Equal(t, baseSubscriptionsPath, apiReq.Path)
+		assert.Equal(t, subscription, apiReq.Body)
+		assert.NotEmpty(t, apiReq.Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a context with an idempotency key should send the subscription with a key derived from it", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(201), nil)
+
+		sut := New(mockedBaseClient)
+		ctx := core.ContextWithIdempotencyKey(context.Background(), "some_key")
+
+		// Act
+		_, err := sut.Create(ctx, &models.SubscriptionRequest{})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "some_key-POST"+baseSubscriptionsPath, mockedBaseClient.SentRequest(0).Headers.Get(core.IdempotencyKeyHeader))
+	})
+
+	t.Run("Given a duplicate conflict after a retried attempt should return the subscription created by the earlier attempt", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		organisationID := uuid.NewString()
+		subscription := &models.SubscriptionRequest{Data: &models.SubscriptionData{ID: id.String(), OrganisationID: organisationID}}
+
+		conflict := coretest.NewResponse(409)
+		conflict.Attempts = 2
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodPost)).Return(conflict, nil)
+		mockedBaseClient.On("Send", coretest.IsMethod(http.MethodGet)).Return(coretest.NewResponse(200), nil).
+			Run(func(args mock.Arguments) {
+				result := args.Get(0).(*core.Request).Result.(*models.SubscriptionResponse)
+				result.Data = &models.SubscriptionData{ID: id.String(), OrganisationID: organisationID}
+			})
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), subscription)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, id.String(), actual.Data.ID)
+		assert.Equal(t, baseSubscriptionsPath+"/"+id.String(), mockedBaseClient.SentRequest(1).Path)
+	})
+
+	t.Run("Given a response with status code other than 201 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(400), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Create(context.Background(), &models.SubscriptionRequest{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("Given paging and filters should send them as query parameters", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		opts := &ListOptions{
+			PageOptions: core.PageOptions{PageNumber: 2},
+			Filter:      ListFilter{RecordType: []string{"accounts", "payments"}, EventType: []string{"created"}},
+		}
+
+		// Act
+		actual, err := sut.List(context.Background(), opts)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, baseSubscriptionsPath, apiReq.Path)
+		assert.Equal(t, "2", apiReq.QueryParam.Get("page[number]"))
+		assert.Equal(t, []string{"accounts", "payments"}, apiReq.QueryParam["filter[record_type]"])
+		assert.Equal(t, []string{"created"}, apiReq.QueryParam["filter[event_type]"])
+		assert.NotContains(t, apiReq.QueryParam, "page[size]")
+	})
+
+	t.Run("Given a response with status code other than 200 should return error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(500), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.List(context.Background(), nil)
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrServerError))
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("Given a patch should send only the changed attributes with the version", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+		deactivated := true
+		patch := &models.SubscriptionPatchAttributes{Deactivated: &deactivated}
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(200), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Update(context.Background(), id, 3, patch)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodPatch, apiReq.Method)
+		assert.Equal(t, baseSubscriptionsPath+"/"+id.String(), apiReq.Path)
+		body := apiReq.Body.(*models.SubscriptionPatchRequest)
+		assert.Equal(t, id.String(), body.Data.ID)
+		assert.Equal(t, int64(3), *body.Data.Version)
+		assert.Equal(t, patch, body.Data.Attributes)
+	})
+
+	t.Run("Given a response with status code 409 should return a conflict error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(409), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		actual, err := sut.Update(context.Background(), uuid.New(), 0, &models.SubscriptionPatchAttributes{})
+
+		// Assert
+		assert.Nil(t, actual)
+		assert.True(t, errors.Is(err, core.ErrConflict))
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("Given a response with status code 204 should send the version and return no error", func(t *testing.T) {
+		// Arrange
+		id := uuid.New()
+
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(204), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		err := sut.Delete(context.Background(), id, 1)
+
+		// Assert
+		assert.Nil(t, err)
+		apiReq := mockedBaseClient.SentRequest(0)
+		assert.Equal(t, http.MethodDelete, apiReq.Method)
+		assert.Equal(t, baseSubscriptionsPath+"/"+id.String(), apiReq.Path)
+		assert.Equal(t, "1", apiReq.QueryParam.Get("version"))
+	})
+
+	t.Run("Given a response with status code 404 should return a not found error", func(t *testing.T) {
+		// Arrange
+		mockedBaseClient := new(coretest.MockedBaseClient)
+		mockedBaseClient.On("Send", mock.Anything).Return(coretest.NewResponse(404), nil)
+
+		sut := New(mockedBaseClient)
+
+		// Act
+		err := sut.Delete(context.Background(), uuid.New(), 0)
+
+		// Assert
+		assert.True(t, errors.Is(err, core.ErrNotFound))
+	})
+}
@@ -2,9 +2,10 @@ package tests
 
 import (
 	"context"
-	"net/http"
+	"errors"
 	"testing"
 
+	"github.com/danimagb/api-client/pkg/accounts"
 	"github.com/danimagb/api-client/pkg/core"
 	"github.com/danimagb/api-client/pkg/models"
 	"github.com/google/uuid"
@@ -37,7 +38,7 @@ func TestFetch(t *testing.T) {
 		// Assert
 		assert.NotNil(t, err)
 		assert.Nil(t, actual)
-		assert.IsType(t, http.StatusNotFound, err.(*core.ApiClientError).StatusCode)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
 	})
 }
 
@@ -60,7 +61,7 @@ func TestCreate(t *testing.T) {
 		// Assert
 		assert.NotNil(t, err)
 		assert.Nil(t, actual)
-		assert.IsType(t, http.StatusBadRequest, err.(*core.ApiClientError).StatusCode)
+		assert.True(t, errors.Is(err, core.ErrBadRequest))
 	})
 }
 
@@ -88,11 +89,36 @@ func TestDelete(t *testing.T) {
 
 		// Assert
 		assert.NotNil(t, err)
-		assert.IsType(t, http.StatusNotFound, err.(*core.ApiClientError).StatusCode)
+		assert.True(t, errors.Is(err, core.ErrNotFound))
 	})
 }
 
 
+func TestList(t *testing.T) {
+	sut := SetupNewClient(t)
+
+	t.Run("Given existent accounts should iterate through all the pages", func(t *testing.T) {
+		// Arrange
+		for i := 0; i < 3; i++ {
+			if _, err := sut.Accounts.Create(context.Background(), buildTestAccount()); err != nil {
+				t.Errorf("Error creating test account: %v", err)
+			}
+		}
+
+		// Act
+		it := sut.Accounts.ListIterator(&accounts.ListOptions{PageOptions: core.PageOptions{PageSize: 1}})
+
+		pages := 0
+		for it.Next(context.Background()) {
+			pages++
+		}
+
+		// Assert
+		assert.Nil(t, it.Err())
+		assert.GreaterOrEqual(t, pages, 3)
+	})
+}
+
 func buildTestAccount() *models.AccountRequest{
 
 	accountClassification := "Personal"
@@ -113,7 +139,7 @@ func buildTestAccount() *models.AccountRequest{
 				BaseCurrency: "GBP",
 				Bic: "NWBKGB22",
 				Country: &country,
-				Iban: "GB11NWBK40030041426819",
+				Iban: "GB16NWBK40030041426819",
 				JointAccount: &jointAccount,
 				Name: []string{name},
 				SecondaryIdentification: "A1B2C3D4",
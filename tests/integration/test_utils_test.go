@@ -1,22 +1,25 @@
 package tests
 
 import (
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	client "github.com/danimagb/api-client/pkg"
+	"github.com/danimagb/api-client/pkg/core"
+	"github.com/danimagb/api-client/pkg/fakeapi"
 )
 
-const(
-	defaultHost string = "http://localhost:8080/"
-)
-
-
+// SetupNewClient returns a client for the API at API_URL.
+// When API_URL is not set, the client targets an in-memory fake API served for the duration of the test.
 func SetupNewClient(t *testing.T) *client.Client{
 	host := os.Getenv("API_URL")
 	if len(host) == 0{
-		host = defaultHost
+		server := httptest.NewServer(fakeapi.NewHandler())
+		t.Cleanup(server.Close)
+		host = server.URL
 	}
 
 	u, err := url.Parse(host)
@@ -26,7 +29,7 @@ func SetupNewClient(t *testing.T) *client.Client{
 
 	client, err := client.NewClient(
 		client.WithBaseUrl(*u),
-		client.WithTimeoutInMilliseconds(1000),
+		client.WithTimeouts(core.Timeouts{PerAttempt: time.Second}),
 	)
 
 	if err != nil {
@@ -34,4 +37,4 @@ func SetupNewClient(t *testing.T) *client.Client{
 	}
 
 	return client
-}
\ No newline at end of file
+}